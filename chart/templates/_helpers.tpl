@@ -0,0 +1,15 @@
+{{/*
+The namespace the operator runs in. Releases installed in the default namespace keep the operator in the controlplane
+namespace, as the chart always did. Each release needs its own namespace.
+*/}}
+{{- define "cpln-operator.namespace" -}}
+{{- if eq .Release.Namespace "default" }}controlplane{{ else }}{{ .Release.Namespace }}{{ end -}}
+{{- end }}
+
+{{/*
+The prefix of the names of cluster-scoped resources, and of those in the argocd namespace. It's empty for the
+cpln-operator release, so that its resources keep their names, and the release name otherwise.
+*/}}
+{{- define "cpln-operator.prefix" -}}
+{{- if ne .Release.Name "cpln-operator" }}{{ .Release.Name }}-{{ end -}}
+{{- end }}
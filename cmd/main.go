@@ -3,19 +3,14 @@ package main
 import (
 	"flag"
 	"fmt"
+	cplnv1 "github.com/controlplane-com/k8s-operator/pkg/apis/cpln/v1"
 	"github.com/controlplane-com/k8s-operator/pkg/common"
 	"github.com/controlplane-com/k8s-operator/pkg/controllers"
-	"github.com/controlplane-com/k8s-operator/pkg/mutators"
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/labels"
 	"net/http"
 	"os"
 	"runtime"
-	"sigs.k8s.io/controller-runtime/pkg/cache"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
-	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	k8sRuntime "k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -30,6 +25,8 @@ var scheme = k8sRuntime.NewScheme()
 func init() {
 	// Register core K8s types.
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	// Register the cpln.io types. The controllers still work on unstructured objects.
+	utilruntime.Must(cplnv1.AddToScheme(scheme))
 }
 
 func main() {
@@ -41,7 +38,7 @@ func main() {
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false, "Enable leader election for controller manager. "+
-		"Enabling this will ensure there is only one active controller manager.")
+		"Enabling this will ensure there is only one active controller manager. Leave it off with SHARDING_ENABLED.")
 
 	flag.Parse()
 
@@ -51,22 +48,16 @@ func main() {
 	setupLog.Info(fmt.Sprintf("Go Version: %s", runtime.Version()))
 	setupLog.Info(fmt.Sprintf("Go OS/Arch: %s/%s", runtime.GOOS, runtime.GOARCH))
 
+	opts := controllers.OptionsFromEnv()
+
 	// Create the manager
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
 		Metrics:                server.Options{BindAddress: metricsAddr},
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
-		LeaderElectionID:       "example-operator-lock",
-		Cache: cache.Options{
-			ByObject: map[client.Object]cache.ByObject{
-				&corev1.Secret{}: {
-					Label: labels.SelectorFromSet(map[string]string{
-						"app.kubernetes.io/managed-by": "cpln-operator",
-					}),
-				},
-			},
-		},
+		LeaderElectionID:       opts.LeaderElectionID(),
+		Cache:                  opts.CacheOptions(),
 		WebhookServer: webhook.NewServer(webhook.Options{
 			Port:     common.GetEnvInt("WEBHOOK_PORT", 9443),
 			CertDir:  common.GetEnvStr("TLS_CERT_DIR", "/cert"),
@@ -79,7 +70,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err = controllers.BuildControllers(mgr); err != nil {
+	if err = controllers.Setup(mgr, opts); err != nil {
 		setupLog.Error(err, "Unable to create controller", "controller", "controller")
 		os.Exit(1)
 	}
@@ -97,10 +88,6 @@ func main() {
 		os.Exit(1)
 	}
 
-	mgr.GetWebhookServer().Register("/mutate", &admission.Webhook{
-		Handler: mutators.CrMutator{},
-	})
-
 	// Start the manager
 	setupLog.Info("Starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
@@ -0,0 +1,1076 @@
+package v1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// The types-go models don't implement DeepCopyInto, so deepcopy-gen can't walk them. Objects are copied field by field
+// through reflection instead, which can't fail the way an encoding round trip can.
+func deepCopy(in, out any) {
+	reflect.ValueOf(out).Elem().Set(deepCopyValue(reflect.ValueOf(in).Elem()))
+}
+
+func deepCopyValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(deepCopyValue(v.Elem()))
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopyValue(v.Elem()))
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for it := v.MapRange(); it.Next(); {
+			c.SetMapIndex(deepCopyValue(it.Key()), deepCopyValue(it.Value()))
+		}
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopyValue(v.Index(i)))
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopyValue(v.Index(i)))
+		}
+		return c
+	case reflect.Struct:
+		//Unexported fields, like those of time.Time, are copied as they are
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(deepCopyValue(v.Field(i)))
+			}
+		}
+		return c
+	default:
+		return v
+	}
+}
+
+func (in *Agent) DeepCopyInto(out *Agent) {
+	*out = Agent{}
+	deepCopy(in, out)
+}
+
+func (in *Agent) DeepCopy() *Agent {
+	if in == nil {
+		return nil
+	}
+	out := new(Agent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Agent) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *AgentList) DeepCopyInto(out *AgentList) {
+	*out = AgentList{}
+	deepCopy(in, out)
+}
+
+func (in *AgentList) DeepCopy() *AgentList {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *AgentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *AuditContext) DeepCopyInto(out *AuditContext) {
+	*out = AuditContext{}
+	deepCopy(in, out)
+}
+
+func (in *AuditContext) DeepCopy() *AuditContext {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditContext)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *AuditContext) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *AuditContextList) DeepCopyInto(out *AuditContextList) {
+	*out = AuditContextList{}
+	deepCopy(in, out)
+}
+
+func (in *AuditContextList) DeepCopy() *AuditContextList {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditContextList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *AuditContextList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *CloudAccount) DeepCopyInto(out *CloudAccount) {
+	*out = CloudAccount{}
+	deepCopy(in, out)
+}
+
+func (in *CloudAccount) DeepCopy() *CloudAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CloudAccount) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *CloudAccountList) DeepCopyInto(out *CloudAccountList) {
+	*out = CloudAccountList{}
+	deepCopy(in, out)
+}
+
+func (in *CloudAccountList) DeepCopy() *CloudAccountList {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAccountList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CloudAccountList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *ContainerStatusList) DeepCopyInto(out *ContainerStatusList) {
+	*out = ContainerStatusList{}
+	deepCopy(in, out)
+}
+
+func (in *ContainerStatusList) DeepCopy() *ContainerStatusList {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerStatusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ContainerStatusList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *Deployment) DeepCopyInto(out *Deployment) {
+	*out = Deployment{}
+	deepCopy(in, out)
+}
+
+func (in *Deployment) DeepCopy() *Deployment {
+	if in == nil {
+		return nil
+	}
+	out := new(Deployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Deployment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *DeploymentList) DeepCopyInto(out *DeploymentList) {
+	*out = DeploymentList{}
+	deepCopy(in, out)
+}
+
+func (in *DeploymentList) DeepCopy() *DeploymentList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DeploymentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *DeploymentVersion) DeepCopyInto(out *DeploymentVersion) {
+	*out = DeploymentVersion{}
+	deepCopy(in, out)
+}
+
+func (in *DeploymentVersion) DeepCopy() *DeploymentVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DeploymentVersion) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *DeploymentVersionList) DeepCopyInto(out *DeploymentVersionList) {
+	*out = DeploymentVersionList{}
+	deepCopy(in, out)
+}
+
+func (in *DeploymentVersionList) DeepCopy() *DeploymentVersionList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentVersionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DeploymentVersionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *Domain) DeepCopyInto(out *Domain) {
+	*out = Domain{}
+	deepCopy(in, out)
+}
+
+func (in *Domain) DeepCopy() *Domain {
+	if in == nil {
+		return nil
+	}
+	out := new(Domain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Domain) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *DomainList) DeepCopyInto(out *DomainList) {
+	*out = DomainList{}
+	deepCopy(in, out)
+}
+
+func (in *DomainList) DeepCopy() *DomainList {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *Group) DeepCopyInto(out *Group) {
+	*out = Group{}
+	deepCopy(in, out)
+}
+
+func (in *Group) DeepCopy() *Group {
+	if in == nil {
+		return nil
+	}
+	out := new(Group)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Group) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *GroupList) DeepCopyInto(out *GroupList) {
+	*out = GroupList{}
+	deepCopy(in, out)
+}
+
+func (in *GroupList) DeepCopy() *GroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *GroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *Gvc) DeepCopyInto(out *Gvc) {
+	*out = Gvc{}
+	deepCopy(in, out)
+}
+
+func (in *Gvc) DeepCopy() *Gvc {
+	if in == nil {
+		return nil
+	}
+	out := new(Gvc)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Gvc) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *GvcList) DeepCopyInto(out *GvcList) {
+	*out = GvcList{}
+	deepCopy(in, out)
+}
+
+func (in *GvcList) DeepCopy() *GvcList {
+	if in == nil {
+		return nil
+	}
+	out := new(GvcList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *GvcList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *Identity) DeepCopyInto(out *Identity) {
+	*out = Identity{}
+	deepCopy(in, out)
+}
+
+func (in *Identity) DeepCopy() *Identity {
+	if in == nil {
+		return nil
+	}
+	out := new(Identity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Identity) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *IdentityList) DeepCopyInto(out *IdentityList) {
+	*out = IdentityList{}
+	deepCopy(in, out)
+}
+
+func (in *IdentityList) DeepCopy() *IdentityList {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *IdentityList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *Image) DeepCopyInto(out *Image) {
+	*out = Image{}
+	deepCopy(in, out)
+}
+
+func (in *Image) DeepCopy() *Image {
+	if in == nil {
+		return nil
+	}
+	out := new(Image)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Image) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *ImageList) DeepCopyInto(out *ImageList) {
+	*out = ImageList{}
+	deepCopy(in, out)
+}
+
+func (in *ImageList) DeepCopy() *ImageList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ImageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *IpSet) DeepCopyInto(out *IpSet) {
+	*out = IpSet{}
+	deepCopy(in, out)
+}
+
+func (in *IpSet) DeepCopy() *IpSet {
+	if in == nil {
+		return nil
+	}
+	out := new(IpSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *IpSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *IpSetList) DeepCopyInto(out *IpSetList) {
+	*out = IpSetList{}
+	deepCopy(in, out)
+}
+
+func (in *IpSetList) DeepCopy() *IpSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(IpSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *IpSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *JobExecutionStatusList) DeepCopyInto(out *JobExecutionStatusList) {
+	*out = JobExecutionStatusList{}
+	deepCopy(in, out)
+}
+
+func (in *JobExecutionStatusList) DeepCopy() *JobExecutionStatusList {
+	if in == nil {
+		return nil
+	}
+	out := new(JobExecutionStatusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *JobExecutionStatusList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *Location) DeepCopyInto(out *Location) {
+	*out = Location{}
+	deepCopy(in, out)
+}
+
+func (in *Location) DeepCopy() *Location {
+	if in == nil {
+		return nil
+	}
+	out := new(Location)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Location) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *LocationList) DeepCopyInto(out *LocationList) {
+	*out = LocationList{}
+	deepCopy(in, out)
+}
+
+func (in *LocationList) DeepCopy() *LocationList {
+	if in == nil {
+		return nil
+	}
+	out := new(LocationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *LocationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *Mk8sCluster) DeepCopyInto(out *Mk8sCluster) {
+	*out = Mk8sCluster{}
+	deepCopy(in, out)
+}
+
+func (in *Mk8sCluster) DeepCopy() *Mk8sCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(Mk8sCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Mk8sCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *Mk8sClusterList) DeepCopyInto(out *Mk8sClusterList) {
+	*out = Mk8sClusterList{}
+	deepCopy(in, out)
+}
+
+func (in *Mk8sClusterList) DeepCopy() *Mk8sClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(Mk8sClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Mk8sClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *Org) DeepCopyInto(out *Org) {
+	*out = Org{}
+	deepCopy(in, out)
+}
+
+func (in *Org) DeepCopy() *Org {
+	if in == nil {
+		return nil
+	}
+	out := new(Org)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Org) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *OrgList) DeepCopyInto(out *OrgList) {
+	*out = OrgList{}
+	deepCopy(in, out)
+}
+
+func (in *OrgList) DeepCopy() *OrgList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *OrgList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *PersistentVolumeStatusList) DeepCopyInto(out *PersistentVolumeStatusList) {
+	*out = PersistentVolumeStatusList{}
+	deepCopy(in, out)
+}
+
+func (in *PersistentVolumeStatusList) DeepCopy() *PersistentVolumeStatusList {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeStatusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *PersistentVolumeStatusList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *Policy) DeepCopyInto(out *Policy) {
+	*out = Policy{}
+	deepCopy(in, out)
+}
+
+func (in *Policy) DeepCopy() *Policy {
+	if in == nil {
+		return nil
+	}
+	out := new(Policy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Policy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *PolicyList) DeepCopyInto(out *PolicyList) {
+	*out = PolicyList{}
+	deepCopy(in, out)
+}
+
+func (in *PolicyList) DeepCopy() *PolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *PolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *Secret) DeepCopyInto(out *Secret) {
+	*out = Secret{}
+	deepCopy(in, out)
+}
+
+func (in *Secret) DeepCopy() *Secret {
+	if in == nil {
+		return nil
+	}
+	out := new(Secret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Secret) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *SecretList) DeepCopyInto(out *SecretList) {
+	*out = SecretList{}
+	deepCopy(in, out)
+}
+
+func (in *SecretList) DeepCopy() *SecretList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *SecretList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *ServiceAccount) DeepCopyInto(out *ServiceAccount) {
+	*out = ServiceAccount{}
+	deepCopy(in, out)
+}
+
+func (in *ServiceAccount) DeepCopy() *ServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ServiceAccount) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *ServiceAccountList) DeepCopyInto(out *ServiceAccountList) {
+	*out = ServiceAccountList{}
+	deepCopy(in, out)
+}
+
+func (in *ServiceAccountList) DeepCopy() *ServiceAccountList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ServiceAccountList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *User) DeepCopyInto(out *User) {
+	*out = User{}
+	deepCopy(in, out)
+}
+
+func (in *User) DeepCopy() *User {
+	if in == nil {
+		return nil
+	}
+	out := new(User)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *User) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *UserList) DeepCopyInto(out *UserList) {
+	*out = UserList{}
+	deepCopy(in, out)
+}
+
+func (in *UserList) DeepCopy() *UserList {
+	if in == nil {
+		return nil
+	}
+	out := new(UserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *UserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *VolumeSet) DeepCopyInto(out *VolumeSet) {
+	*out = VolumeSet{}
+	deepCopy(in, out)
+}
+
+func (in *VolumeSet) DeepCopy() *VolumeSet {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *VolumeSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *VolumeSetList) DeepCopyInto(out *VolumeSetList) {
+	*out = VolumeSetList{}
+	deepCopy(in, out)
+}
+
+func (in *VolumeSetList) DeepCopy() *VolumeSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *VolumeSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *VolumeSetStatusLocation) DeepCopyInto(out *VolumeSetStatusLocation) {
+	*out = VolumeSetStatusLocation{}
+	deepCopy(in, out)
+}
+
+func (in *VolumeSetStatusLocation) DeepCopy() *VolumeSetStatusLocation {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSetStatusLocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *VolumeSetStatusLocation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *VolumeSetStatusLocationList) DeepCopyInto(out *VolumeSetStatusLocationList) {
+	*out = VolumeSetStatusLocationList{}
+	deepCopy(in, out)
+}
+
+func (in *VolumeSetStatusLocationList) DeepCopy() *VolumeSetStatusLocationList {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSetStatusLocationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *VolumeSetStatusLocationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *Workload) DeepCopyInto(out *Workload) {
+	*out = Workload{}
+	deepCopy(in, out)
+}
+
+func (in *Workload) DeepCopy() *Workload {
+	if in == nil {
+		return nil
+	}
+	out := new(Workload)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Workload) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *WorkloadList) DeepCopyInto(out *WorkloadList) {
+	*out = WorkloadList{}
+	deepCopy(in, out)
+}
+
+func (in *WorkloadList) DeepCopy() *WorkloadList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *WorkloadList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *ContainerStatus) DeepCopyInto(out *ContainerStatus) {
+	*out = ContainerStatus{}
+	deepCopy(in, out)
+}
+
+func (in *ContainerStatus) DeepCopy() *ContainerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ContainerStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *JobExecutionStatus) DeepCopyInto(out *JobExecutionStatus) {
+	*out = JobExecutionStatus{}
+	deepCopy(in, out)
+}
+
+func (in *JobExecutionStatus) DeepCopy() *JobExecutionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JobExecutionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *JobExecutionStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *PersistentVolumeStatus) DeepCopyInto(out *PersistentVolumeStatus) {
+	*out = PersistentVolumeStatus{}
+	deepCopy(in, out)
+}
+
+func (in *PersistentVolumeStatus) DeepCopy() *PersistentVolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *PersistentVolumeStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
@@ -0,0 +1,8 @@
+// Package v1 contains the typed API for the cpln.io/v1 custom resources. The controllers work on unstructured objects,
+// so these types are meant for programs that consume the CRs, either through the generated clientset in
+// pkg/generated or through a controller-runtime client built with AddToScheme.
+//
+// Kinds are lowercase on the wire (e.g. "workload"), matching the Control Plane API and the CRDs in chart/templates/crd.
+//
+// +groupName=cpln.io
+package v1
@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var SchemeGroupVersion = schema.GroupVersion{Group: common.API_GROUP, Version: common.API_REVISION}
+
+var (
+	SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
+	AddToScheme   = SchemeBuilder.AddToScheme
+)
+
+// Kind takes an unqualified kind and returns a Group qualified GroupKind
+func Kind(kind string) schema.GroupKind {
+	return SchemeGroupVersion.WithKind(kind).GroupKind()
+}
+
+// Resource takes an unqualified resource and returns a Group qualified GroupResource
+func Resource(resource string) schema.GroupResource {
+	return SchemeGroupVersion.WithResource(resource).GroupResource()
+}
+
+// knownTypes maps the (lowercase) kind of each CRD to its object and list types
+var knownTypes = map[string][2]runtime.Object{
+	"agent":                   {&Agent{}, &AgentList{}},
+	"auditctx":                {&AuditContext{}, &AuditContextList{}},
+	"cloudaccount":            {&CloudAccount{}, &CloudAccountList{}},
+	"containerstatus":         {&ContainerStatus{}, &ContainerStatusList{}},
+	"deployment":              {&Deployment{}, &DeploymentList{}},
+	"deploymentversion":       {&DeploymentVersion{}, &DeploymentVersionList{}},
+	"domain":                  {&Domain{}, &DomainList{}},
+	"group":                   {&Group{}, &GroupList{}},
+	"gvc":                     {&Gvc{}, &GvcList{}},
+	"identity":                {&Identity{}, &IdentityList{}},
+	"image":                   {&Image{}, &ImageList{}},
+	"ipset":                   {&IpSet{}, &IpSetList{}},
+	"jobexecutionstatus":      {&JobExecutionStatus{}, &JobExecutionStatusList{}},
+	"location":                {&Location{}, &LocationList{}},
+	"mk8scluster":             {&Mk8sCluster{}, &Mk8sClusterList{}},
+	"org":                     {&Org{}, &OrgList{}},
+	"persistentvolumestatus":  {&PersistentVolumeStatus{}, &PersistentVolumeStatusList{}},
+	"policy":                  {&Policy{}, &PolicyList{}},
+	"secret":                  {&Secret{}, &SecretList{}},
+	"serviceaccount":          {&ServiceAccount{}, &ServiceAccountList{}},
+	"user":                    {&User{}, &UserList{}},
+	"volumeset":               {&VolumeSet{}, &VolumeSetList{}},
+	"volumesetstatuslocation": {&VolumeSetStatusLocation{}, &VolumeSetStatusLocationList{}},
+	"workload":                {&Workload{}, &WorkloadList{}},
+}
+
+func addKnownTypes(scheme *runtime.Scheme) error {
+	for kind, types := range knownTypes {
+		scheme.AddKnownTypeWithName(SchemeGroupVersion.WithKind(kind), types[0])
+		scheme.AddKnownTypeWithName(SchemeGroupVersion.WithKind(kind+"List"), types[1])
+	}
+	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
+	return nil
+}
@@ -0,0 +1,763 @@
+package v1
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/controlplane-com/types-go/pkg/agent"
+	"github.com/controlplane-com/types-go/pkg/auditctx"
+	"github.com/controlplane-com/types-go/pkg/base"
+	"github.com/controlplane-com/types-go/pkg/cloudaccount"
+	"github.com/controlplane-com/types-go/pkg/containerstatus"
+	"github.com/controlplane-com/types-go/pkg/cronjob"
+	"github.com/controlplane-com/types-go/pkg/deployment"
+	"github.com/controlplane-com/types-go/pkg/domain"
+	"github.com/controlplane-com/types-go/pkg/group"
+	"github.com/controlplane-com/types-go/pkg/gvc"
+	"github.com/controlplane-com/types-go/pkg/identity"
+	"github.com/controlplane-com/types-go/pkg/image"
+	"github.com/controlplane-com/types-go/pkg/ipSet"
+	"github.com/controlplane-com/types-go/pkg/location"
+	"github.com/controlplane-com/types-go/pkg/mk8s"
+	"github.com/controlplane-com/types-go/pkg/org"
+	"github.com/controlplane-com/types-go/pkg/policy"
+	"github.com/controlplane-com/types-go/pkg/query"
+	"github.com/controlplane-com/types-go/pkg/secret"
+	"github.com/controlplane-com/types-go/pkg/serviceaccount"
+	"github.com/controlplane-com/types-go/pkg/volumeSet"
+	"github.com/controlplane-com/types-go/pkg/workload"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The CRs keep the Control Plane fields at the root of the object, next to apiVersion/kind/metadata. The types-go
+// models can't be embedded whole because their kind field would collide with TypeMeta, so each type lists its fields
+// explicitly and reuses the types-go types for everything below the root.
+
+// Base holds the fields every pushed kind shares with the Control Plane API
+type Base struct {
+	// Org is the organization that owns the resource
+	Org string `json:"org"`
+	// CplnName is the name of the resource in Control Plane. It usually matches metadata.name.
+	CplnName     string            `json:"name,omitempty"`
+	ID           string            `json:"id,omitempty"`
+	Description  string            `json:"description,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty"`
+	Version      float32           `json:"version,omitempty"`
+	Created      string            `json:"created,omitempty"`
+	LastModified string            `json:"lastModified,omitempty"`
+	Links        base.Links        `json:"links,omitempty"`
+}
+
+// Condition is an entry of status.conditions
+type Condition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// OperatorStatus is the bookkeeping the operator keeps in status.operator
+type OperatorStatus struct {
+	// AppliedActions holds the values of the action annotations (e.g. cpln.io/reconcile-requested-at) the operator
+	// last acted on
+	AppliedActions map[string]string `json:"appliedActions,omitempty"`
+	// ApprovedBy is the user who approved the plan of the last push, in namespaces that require approval
+	ApprovedBy              string `json:"approvedBy,omitempty"`
+	DownstreamOnly          bool   `json:"downstreamOnly,omitempty"`
+	HealthStatusMessage     string `json:"healthStatusMessage,omitempty"`
+	LastProcessedGeneration int64  `json:"lastProcessedGeneration,omitempty"`
+	LastSyncTime            string `json:"lastSyncTime,omitempty"`
+	LastSyncedGeneration    int64  `json:"lastSyncedGeneration,omitempty"`
+	// LastSyncedGenerationTime is when the last synced generation was first synced. Polls don't move it.
+	LastSyncedGenerationTime string `json:"lastSyncedGenerationTime,omitempty"`
+	// MergeBase is the Control Plane resource as of the last sync, kept as JSON with the merge conflict policy
+	MergeBase     string `json:"mergeBase,omitempty"`
+	NextRetryTime string `json:"nextRetryTime,omitempty"`
+	// Plan lists the changes a push would make in Control Plane, while the CR is in plan mode
+	Plan string `json:"plan,omitempty"`
+	// PlanHash identifies Plan
+	PlanHash string `json:"planHash,omitempty"`
+	// ReconcileRequestedAt is the cpln.io/reconcile-requested-at value that last reset the sync backoff
+	ReconcileRequestedAt string `json:"reconcileRequestedAt,omitempty"`
+	// RemoteVersion is the Control Plane version of the resource as of the last sync
+	RemoteVersion   int64  `json:"remoteVersion,omitempty"`
+	SyncRetries     int64  `json:"syncRetries,omitempty"`
+	ValidationError string `json:"validationError,omitempty"`
+	// WouldHaveDone lists the latest actions skipped in shadow mode
+	WouldHaveDone []string `json:"wouldHaveDone,omitempty"`
+}
+
+// CommonStatus holds the status fields the operator sets on every kind
+type CommonStatus struct {
+	Phase      string          `json:"phase,omitempty"`
+	Conditions []Condition     `json:"conditions,omitempty"`
+	Operator   *OperatorStatus `json:"operator,omitempty"`
+}
+
+// encoding/json only inlines embedded structs, so the statuses that Control Plane models as maps are merged with
+// CommonStatus by hand
+func marshalMapStatus(status map[string]any, common CommonStatus) ([]byte, error) {
+	b, err := json.Marshal(common)
+	if err != nil {
+		return nil, err
+	}
+	merged := map[string]any{}
+	if err = json.Unmarshal(b, &merged); err != nil {
+		return nil, err
+	}
+	for k, v := range status {
+		if _, ok := merged[k]; !ok {
+			merged[k] = v
+		}
+	}
+	return json.Marshal(merged)
+}
+
+func unmarshalMapStatus(b []byte) (map[string]any, CommonStatus, error) {
+	var common CommonStatus
+	if err := json.Unmarshal(b, &common); err != nil {
+		return nil, common, err
+	}
+	var status map[string]any
+	if err := json.Unmarshal(b, &status); err != nil {
+		return nil, common, err
+	}
+	t := reflect.TypeOf(common)
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		delete(status, name)
+	}
+	if len(status) == 0 {
+		status = nil
+	}
+	return status, common, nil
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Agent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Base              `json:",inline"`
+	Status            AgentStatus `json:"status,omitempty"`
+}
+
+type AgentStatus struct {
+	agent.AgentStatus `json:",inline"`
+	CommonStatus      `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type AgentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Agent `json:"items"`
+}
+
+// +genclient
+// +resourceName=auditcontexts
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type AuditContext struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Base              `json:",inline"`
+	Origin            auditctx.AuditContextOrigin `json:"origin,omitempty"`
+	Status            AuditContextStatus          `json:"status,omitempty"`
+}
+
+type AuditContextStatus struct {
+	auditctx.AuditContextStatus `json:",inline"`
+	CommonStatus                `json:",inline"`
+}
+
+func (s AuditContextStatus) MarshalJSON() ([]byte, error) {
+	return marshalMapStatus(s.AuditContextStatus, s.CommonStatus)
+}
+
+func (s *AuditContextStatus) UnmarshalJSON(b []byte) error {
+	status, common, err := unmarshalMapStatus(b)
+	if err != nil {
+		return err
+	}
+	s.AuditContextStatus, s.CommonStatus = status, common
+	return nil
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type AuditContextList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AuditContext `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type CloudAccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Base              `json:",inline"`
+	Provider          base.CloudProvider `json:"provider,omitempty"`
+	Data              any                `json:"data,omitempty"`
+	Status            CloudAccountStatus `json:"status,omitempty"`
+}
+
+type CloudAccountStatus struct {
+	cloudaccount.CloudAccountStatus `json:",inline"`
+	CommonStatus                    `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type CloudAccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudAccount `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ContainerStatus is written by the operator for each container of a DeploymentVersion
+type ContainerStatus struct {
+	metav1.TypeMeta                 `json:",inline"`
+	metav1.ObjectMeta               `json:"metadata,omitempty"`
+	Org                             string `json:"org"`
+	containerstatus.ContainerStatus `json:",inline"`
+	Status                          CommonStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ContainerStatusList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ContainerStatus `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Deployment is written by the operator for each location a Workload is deployed to
+type Deployment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Org               string           `json:"org"`
+	CplnName          string           `json:"name,omitempty"`
+	LastModified      string           `json:"lastModified,omitempty"`
+	Links             base.Links       `json:"links,omitempty"`
+	Status            DeploymentStatus `json:"status,omitempty"`
+}
+
+type DeploymentStatus struct {
+	deployment.DeploymentStatus `json:",inline"`
+	CommonStatus                `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type DeploymentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Deployment `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeploymentVersion is written by the operator for each version of a Deployment
+type DeploymentVersion struct {
+	metav1.TypeMeta              `json:",inline"`
+	metav1.ObjectMeta            `json:"metadata,omitempty"`
+	Org                          string `json:"org"`
+	deployment.DeploymentVersion `json:",inline"`
+	Status                       CommonStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type DeploymentVersionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeploymentVersion `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Domain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Base              `json:",inline"`
+	Spec              domain.DomainSpec `json:"spec,omitempty"`
+	Status            DomainStatus      `json:"status,omitempty"`
+}
+
+type DomainStatus struct {
+	domain.DomainStatus `json:",inline"`
+	CommonStatus        `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type DomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Domain `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Group struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Base              `json:",inline"`
+	MemberLinks       []string                    `json:"memberLinks,omitempty"`
+	MemberQuery       *query.Query                `json:"memberQuery,omitempty"`
+	IdentityMatcher   *group.GroupIdentityMatcher `json:"identityMatcher,omitempty"`
+	Origin            group.GroupOrigin           `json:"origin,omitempty"`
+	Status            CommonStatus                `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type GroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Group `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Gvc struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Base              `json:",inline"`
+	Alias             string       `json:"alias,omitempty"`
+	Spec              *gvc.GvcSpec `json:"spec,omitempty"`
+	Status            GvcStatus    `json:"status,omitempty"`
+}
+
+type GvcStatus struct {
+	gvc.GvcStatus `json:",inline"`
+	CommonStatus  `json:",inline"`
+}
+
+func (s GvcStatus) MarshalJSON() ([]byte, error) {
+	return marshalMapStatus(s.GvcStatus, s.CommonStatus)
+}
+
+func (s *GvcStatus) UnmarshalJSON(b []byte) error {
+	status, common, err := unmarshalMapStatus(b)
+	if err != nil {
+		return err
+	}
+	s.GvcStatus, s.CommonStatus = status, common
+	return nil
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type GvcList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Gvc `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Identity struct {
+	metav1.TypeMeta        `json:",inline"`
+	metav1.ObjectMeta      `json:"metadata,omitempty"`
+	Base                   `json:",inline"`
+	Gvc                    string                           `json:"gvc"`
+	Aws                    *identity.AwsIdentity            `json:"aws,omitempty"`
+	Gcp                    *identity.GcpIdentity            `json:"gcp,omitempty"`
+	Azure                  *identity.AzureIdentity          `json:"azure,omitempty"`
+	Ngs                    *identity.NgsIdentity            `json:"ngs,omitempty"`
+	NetworkResources       []identity.NetworkResource       `json:"networkResources,omitempty"`
+	NativeNetworkResources []identity.NativeNetworkResource `json:"nativeNetworkResources,omitempty"`
+	MemcacheAccess         []identity.MemcacheAccess        `json:"memcacheAccess,omitempty"`
+	SpicedbAccess          []identity.SpicedbAccess         `json:"spicedbAccess,omitempty"`
+	Status                 IdentityStatus                   `json:"status,omitempty"`
+}
+
+type IdentityStatus struct {
+	identity.Status `json:",inline"`
+	CommonStatus    `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type IdentityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Identity `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Image struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Base              `json:",inline"`
+	Repository        string              `json:"repository,omitempty"`
+	Tag               string              `json:"tag,omitempty"`
+	Manifest          image.ImageManifest `json:"manifest,omitempty"`
+	Digest            string              `json:"digest,omitempty"`
+	Status            CommonStatus        `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ImageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Image `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type IpSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Base              `json:",inline"`
+	Spec              ipSet.IpSetSpec `json:"spec"`
+	Status            IpSetStatus     `json:"status,omitempty"`
+}
+
+type IpSetStatus struct {
+	ipSet.IpSetStatus `json:",inline"`
+	CommonStatus      `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type IpSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IpSet `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// JobExecutionStatus is written by the operator for each job execution of a cron Workload. The Control Plane status
+// of the execution is not kept, since status holds the operator status.
+type JobExecutionStatus struct {
+	metav1.TypeMeta            `json:",inline"`
+	metav1.ObjectMeta          `json:"metadata,omitempty"`
+	Org                        string `json:"org"`
+	cronjob.JobExecutionStatus `json:",inline"`
+	Status                     CommonStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type JobExecutionStatusList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JobExecutionStatus `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Location struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Base              `json:",inline"`
+	Origin            location.LocationOrigin   `json:"origin,omitempty"`
+	Provider          location.LocationProvider `json:"provider,omitempty"`
+	Region            string                    `json:"region,omitempty"`
+	Spec              location.LocationSpec     `json:"spec,omitempty"`
+	Status            LocationStatus            `json:"status,omitempty"`
+}
+
+type LocationStatus struct {
+	location.LocationStatus `json:",inline"`
+	CommonStatus            `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type LocationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Location `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Mk8sCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Base              `json:",inline"`
+	Alias             string               `json:"alias,omitempty"`
+	Spec              mk8s.Mk8SClusterSpec `json:"spec"`
+	Status            Mk8sClusterStatus    `json:"status,omitempty"`
+}
+
+type Mk8sClusterStatus struct {
+	mk8s.Mk8sStatus `json:",inline"`
+	CommonStatus    `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Mk8sClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Mk8sCluster `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Org struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Base              `json:",inline"`
+	Spec              org.OrgSpec `json:"spec,omitempty"`
+	Status            OrgStatus   `json:"status,omitempty"`
+}
+
+type OrgStatus struct {
+	org.OrgStatus `json:",inline"`
+	CommonStatus  `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type OrgList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Org `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PersistentVolumeStatus is written by the operator for each volume of a VolumeSet location
+type PersistentVolumeStatus struct {
+	metav1.TypeMeta                  `json:",inline"`
+	metav1.ObjectMeta                `json:"metadata,omitempty"`
+	Org                              string `json:"org"`
+	volumeSet.PersistentVolumeStatus `json:",inline"`
+	Status                           CommonStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type PersistentVolumeStatusList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PersistentVolumeStatus `json:"items"`
+}
+
+// +genclient
+// +resourceName=policies
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Policy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Base              `json:",inline"`
+	TargetKind        base.Kind           `json:"targetKind,omitempty"`
+	TargetLinks       []string            `json:"targetLinks,omitempty"`
+	TargetQuery       *query.Query        `json:"targetQuery,omitempty"`
+	Target            policy.PolicyTarget `json:"target,omitempty"`
+	Origin            policy.PolicyOrigin `json:"origin,omitempty"`
+	Bindings          []policy.Binding    `json:"bindings,omitempty"`
+	Status            CommonStatus        `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type PolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Policy `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Secret struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Base              `json:",inline"`
+	Type              secret.SecretType `json:"type,omitempty"`
+	Data              secret.SecretData `json:"data,omitempty"`
+	Status            CommonStatus      `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type SecretList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Secret `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ServiceAccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Base              `json:",inline"`
+	Keys              []serviceaccount.ServiceAccountKey  `json:"keys,omitempty"`
+	Origin            serviceaccount.ServiceAccountOrigin `json:"origin,omitempty"`
+	Status            CommonStatus                        `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ServiceAccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceAccount `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type User struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Base              `json:",inline"`
+	Idp               string       `json:"idp,omitempty"`
+	Email             string       `json:"email,omitempty"`
+	Status            CommonStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type UserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []User `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VolumeSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Base              `json:",inline"`
+	Gvc               string                  `json:"gvc"`
+	Spec              volumeSet.VolumeSetSpec `json:"spec"`
+	Status            VolumeSetStatus         `json:"status,omitempty"`
+}
+
+type VolumeSetStatus struct {
+	volumeSet.VolumeSetStatus `json:",inline"`
+	CommonStatus              `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VolumeSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeSet `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VolumeSetStatusLocation is written by the operator for each location of a VolumeSet
+type VolumeSetStatusLocation struct {
+	metav1.TypeMeta                   `json:",inline"`
+	metav1.ObjectMeta                 `json:"metadata,omitempty"`
+	Org                               string `json:"org"`
+	volumeSet.VolumeSetStatusLocation `json:",inline"`
+	Status                            CommonStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VolumeSetStatusLocationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeSetStatusLocation `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Workload struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Base              `json:",inline"`
+	Gvc               string                `json:"gvc"`
+	Spec              workload.WorkloadSpec `json:"spec"`
+	Status            WorkloadStatus        `json:"status,omitempty"`
+}
+
+type WorkloadStatus struct {
+	workload.WorkloadStatus `json:",inline"`
+	CommonStatus            `json:",inline"`
+	// Health is a rollup of the workload's deployments
+	Health *WorkloadHealth `json:"health,omitempty"`
+	// Locations is only set when WORKLOAD_STATUS_MODE is summary
+	Locations []LocationSummary `json:"locations,omitempty"`
+}
+
+type WorkloadHealth struct {
+	// Readiness is one of ready, unhealthy, suspended or progressing
+	Readiness      string `json:"readiness,omitempty"`
+	ReadyLocations int64  `json:"readyLocations"`
+	TotalLocations int64  `json:"totalLocations"`
+	ReadyReplicas  int64  `json:"readyReplicas"`
+	TotalReplicas  int64  `json:"totalReplicas"`
+}
+
+type LocationSummary struct {
+	Name          string           `json:"name"`
+	Phase         string           `json:"phase,omitempty"`
+	Ready         bool             `json:"ready"`
+	ReadyReplicas int64            `json:"readyReplicas"`
+	TotalReplicas int64            `json:"totalReplicas"`
+	Message       string           `json:"message,omitempty"`
+	Versions      []VersionSummary `json:"versions,omitempty"`
+}
+
+type VersionSummary struct {
+	Name       string             `json:"name"`
+	Workload   int64              `json:"workload"`
+	Ready      bool               `json:"ready"`
+	Message    string             `json:"message,omitempty"`
+	Zone       string             `json:"zone,omitempty"`
+	Containers []ContainerSummary `json:"containers,omitempty"`
+}
+
+type ContainerSummary struct {
+	Name          string `json:"name"`
+	Image         string `json:"image,omitempty"`
+	Ready         bool   `json:"ready"`
+	ReadyReplicas int64  `json:"readyReplicas"`
+	TotalReplicas int64  `json:"totalReplicas"`
+	Message       string `json:"message,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type WorkloadList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Workload `json:"items"`
+}
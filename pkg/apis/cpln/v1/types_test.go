@@ -0,0 +1,194 @@
+package v1_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	cplnv1 "github.com/controlplane-com/k8s-operator/pkg/apis/cpln/v1"
+	"github.com/controlplane-com/k8s-operator/pkg/generated/clientset/versioned/fake"
+	"github.com/controlplane-com/types-go/pkg/auditctx"
+	"github.com/controlplane-com/types-go/pkg/gvc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestWorkloadFromUnstructured(t *testing.T) {
+	// Scenario: A workload CR as the operator writes it; the Control Plane and operator status fields share status.
+	u := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "cpln.io/v1",
+		"kind":       "workload",
+		"metadata":   map[string]any{"name": "api", "namespace": "default"},
+		"org":        "my-org",
+		"gvc":        "my-gvc",
+		"spec":       map[string]any{"type": "standard"},
+		"status": map[string]any{
+			"phase":             "Ready",
+			"canonicalEndpoint": "https://api.example.com",
+			"operator":          map[string]any{"lastSyncedGeneration": int64(3)},
+			"health":            map[string]any{"readiness": "ready", "readyLocations": int64(2)},
+		},
+	}}
+
+	w := &cplnv1.Workload{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, w); err != nil {
+		t.Fatalf("FromUnstructured returned error: %v", err)
+	}
+	if w.Org != "my-org" || w.Gvc != "my-gvc" || w.GetName() != "api" {
+		t.Errorf("root fields = %q/%q/%q, want my-org/my-gvc/api", w.Org, w.Gvc, w.GetName())
+	}
+	if w.Status.Phase != "Ready" {
+		t.Errorf("Status.Phase = %q, want Ready", w.Status.Phase)
+	}
+	if w.Status.CanonicalEndpoint != "https://api.example.com" {
+		t.Errorf("Status.CanonicalEndpoint = %q, want https://api.example.com", w.Status.CanonicalEndpoint)
+	}
+	if w.Status.Operator == nil || w.Status.Operator.LastSyncedGeneration != 3 {
+		t.Errorf("Status.Operator = %+v, want lastSyncedGeneration 3", w.Status.Operator)
+	}
+	if w.Status.Health == nil || w.Status.Health.ReadyLocations != 2 {
+		t.Errorf("Status.Health = %+v, want readyLocations 2", w.Status.Health)
+	}
+
+	// Scenario: DeepCopy returns an equal but independent object.
+	c := w.DeepCopy()
+	c.Status.Operator.LastSyncedGeneration = 4
+	if w.Status.Operator.LastSyncedGeneration != 3 {
+		t.Errorf("DeepCopy shares status.operator with the original")
+	}
+}
+
+func TestAddToScheme(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := cplnv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme returned error: %v", err)
+	}
+
+	// Kinds are registered in lowercase, as they appear in the CRDs.
+	for kind, want := range map[string]runtime.Object{
+		"workload":     &cplnv1.Workload{},
+		"auditctx":     &cplnv1.AuditContext{},
+		"mk8scluster":  &cplnv1.Mk8sCluster{},
+		"workloadList": &cplnv1.WorkloadList{},
+	} {
+		obj, err := scheme.New(cplnv1.SchemeGroupVersion.WithKind(kind))
+		if err != nil {
+			t.Errorf("scheme.New(%q) returned error: %v", kind, err)
+			continue
+		}
+		if reflect.TypeOf(obj) != reflect.TypeOf(want) {
+			t.Errorf("scheme.New(%q) = %T, want %T", kind, obj, want)
+		}
+	}
+}
+
+func TestFakeClientset(t *testing.T) {
+	// Scenario: The fake clientset creates and lists CRs, which needs its kinds to be the ones in the scheme.
+	ctx := context.Background()
+	client := fake.NewSimpleClientset()
+
+	w := &cplnv1.Workload{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"}, Gvc: "my-gvc"}
+	w.Org = "my-org"
+	if _, err := client.CplnV1().Workloads("default").Create(ctx, w, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	workloads, err := client.CplnV1().Workloads("default").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(workloads.Items) != 1 || workloads.Items[0].Gvc != "my-gvc" {
+		t.Errorf("List returned %+v, want the created workload", workloads.Items)
+	}
+
+	// Scenario: The kind of an audit context differs from its type's name.
+	a := &cplnv1.AuditContext{ObjectMeta: metav1.ObjectMeta{Name: "audit", Namespace: "default"}}
+	if _, err := client.CplnV1().AuditContexts("default").Create(ctx, a, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	contexts, err := client.CplnV1().AuditContexts("default").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(contexts.Items) != 1 {
+		t.Errorf("List returned %d audit contexts, want 1", len(contexts.Items))
+	}
+}
+
+func TestMapStatusRoundTrip(t *testing.T) {
+	g := &cplnv1.Gvc{
+		Base: cplnv1.Base{Org: "my-org"},
+		Status: cplnv1.GvcStatus{
+			GvcStatus: gvc.GvcStatus{"endpoint": "https://main.example.com"},
+			CommonStatus: cplnv1.CommonStatus{
+				Phase:    "Ready",
+				Operator: &cplnv1.OperatorStatus{LastSyncedGeneration: 3},
+			},
+		},
+	}
+
+	// Scenario: The Control Plane status sits next to the operator status, as in the CRD.
+	b, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	var raw map[string]any
+	if err := json.Unmarshal(b, &raw); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"endpoint": "https://main.example.com",
+		"phase":    "Ready",
+		"operator": map[string]any{"lastSyncedGeneration": float64(3)},
+	}
+	if !reflect.DeepEqual(raw["status"], want) {
+		t.Errorf("status = %v, want %v", raw["status"], want)
+	}
+
+	// Scenario: Reading it back, through JSON or the unstructured form, splits the fields the same way.
+	decoded := &cplnv1.Gvc{}
+	if err := json.Unmarshal(b, decoded); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded.Status, g.Status) {
+		t.Errorf("decoded status = %+v, want %+v", decoded.Status, g.Status)
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(g)
+	if err != nil {
+		t.Fatalf("ToUnstructured returned error: %v", err)
+	}
+	if phase, _, _ := unstructured.NestedString(u, "status", "phase"); phase != "Ready" {
+		t.Errorf("unstructured status.phase = %q, want Ready", phase)
+	}
+	a := &cplnv1.AuditContext{}
+	u = map[string]any{"status": map[string]any{"phase": "Ready", "retention": "30d"}}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, a); err != nil {
+		t.Fatalf("FromUnstructured returned error: %v", err)
+	}
+	if a.Status.Phase != "Ready" || !reflect.DeepEqual(a.Status.AuditContextStatus, auditctx.AuditContextStatus{"retention": "30d"}) {
+		t.Errorf("audit context status = %+v, want the phase and the retention split", a.Status)
+	}
+}
+
+func TestDeepCopy(t *testing.T) {
+	g := &cplnv1.Gvc{
+		ObjectMeta: metav1.ObjectMeta{Name: "main", CreationTimestamp: metav1.Now(), Labels: map[string]string{"a": "b"}},
+		Status: cplnv1.GvcStatus{
+			GvcStatus:    gvc.GvcStatus{"nested": map[string]any{"key": "value"}},
+			CommonStatus: cplnv1.CommonStatus{Conditions: []cplnv1.Condition{{Type: "Synced", Status: "True"}}},
+		},
+	}
+
+	// Scenario: The copy is equal, and changing it leaves the original alone, down to nested maps.
+	c := g.DeepCopy()
+	if !reflect.DeepEqual(c, g) {
+		t.Fatalf("copy = %+v, want %+v", c, g)
+	}
+	c.Labels["a"] = "changed"
+	c.Status.GvcStatus["nested"].(map[string]any)["key"] = "changed"
+	c.Status.Conditions[0].Status = "False"
+	if g.Labels["a"] != "b" || g.Status.GvcStatus["nested"].(map[string]any)["key"] != "value" || g.Status.Conditions[0].Status != "True" {
+		t.Errorf("changing the copy changed the original: %+v", g)
+	}
+}
@@ -8,6 +8,13 @@ const (
 	FINALIZER            = "cpln.io/sync-protection"
 	CONTROLLER_NAMESPACE = "controlplane"
 
+	KIND_AGENT                      = "agent"
+	KIND_CLOUD_ACCOUNT              = "cloudaccount"
+	KIND_DOMAIN                     = "domain"
+	KIND_ORG                        = "org"
+	KIND_GVC                        = "gvc"
+	KIND_IDENTITY                   = "identity"
+	KIND_MK8S                       = "mk8scluster"
 	KIND_WORKLOAD                   = "workload"
 	KIND_VOLUME_SET                 = "volumeset"
 	KIND_VOLUME_SET_STATUS_LOCATION = "volumesetstatuslocation"
@@ -21,8 +28,61 @@ const (
 	KIND_NATIVE_SECRET              = "Secret"
 	KIND_CPLN_SECRET                = "secret"
 
+	// OPERATOR_CLASS_LABEL picks the operator instance that manages a CR or Secret. Unlabelled resources belong to
+	// DEFAULT_OPERATOR_CLASS.
+	OPERATOR_CLASS_LABEL   = "cpln.io/operator-class"
+	DEFAULT_OPERATOR_CLASS = "default"
+
+	// SHARD_LABEL is set to the operator class on the Lease each replica renews while sharding
+	SHARD_LABEL = "cpln.io/operator-shard"
+
 	RESOURCE_POLICY_ANNOTATION = "cpln.io/resource-policy"
 	RESOURCE_POLICY_KEEP       = "keep"
 
+	// Deleting the CR of a kind with the "confirm" deletion policy only deletes its resource from Control Plane once
+	// CONFIRM_DELETE_ANNOTATION is set to the name of the CR. Kinds with the "never" policy are never deleted from it.
+	CONFIRM_DELETE_ANNOTATION = "cpln.io/confirm-delete"
+	DELETION_POLICY_DELETE    = "delete"
+	DELETION_POLICY_CONFIRM   = "confirm"
+	DELETION_POLICY_NEVER     = "never"
+
+	// PLAN_ONLY_ANNOTATION set to "true" records the changes a push would make in the status of a CR, without pushing
+	PLAN_ONLY_ANNOTATION = "cpln.io/plan-only"
+
+	// Changes to CRs in namespaces with REQUIRE_APPROVAL_LABEL set to "true" are pushed once APPROVED_PLAN_ANNOTATION
+	// matches the hash of their plan. The webhook records who set it in APPROVED_BY_ANNOTATION, and who last changed
+	// the CR in CHANGED_BY_ANNOTATION, who can't approve the change.
+	REQUIRE_APPROVAL_LABEL   = "cpln.io/require-approval"
+	APPROVED_PLAN_ANNOTATION = "cpln.io/approved-plan"
+	APPROVED_BY_ANNOTATION   = "cpln.io/approved-by"
+	CHANGED_BY_ANNOTATION    = "cpln.io/changed-by"
+
+	// CONFLICT_POLICY_ANNOTATION overrides how a push handles changes made in Control Plane since the last sync.
+	// OVERWRITE_VERSION_ANNOTATION lets a held push overwrite the given Control Plane version.
+	CONFLICT_POLICY_ANNOTATION   = "cpln.io/conflict-policy"
+	OVERWRITE_VERSION_ANNOTATION = "cpln.io/overwrite-version"
+	CONFLICT_POLICY_ABORT        = "abort"
+	CONFLICT_POLICY_MERGE        = "merge"
+	CONFLICT_POLICY_OVERWRITE    = "overwrite"
+
+	// REMOTE_DELETION_POLICY_ANNOTATION picks what happens to a CR whose resource was deleted in Control Plane
+	REMOTE_DELETION_POLICY_ANNOTATION = "cpln.io/remote-deletion-policy"
+	REMOTE_DELETION_POLICY_DELETE     = "delete"
+	REMOTE_DELETION_POLICY_RECREATE   = "recreate"
+	REMOTE_DELETION_POLICY_KEEP       = "keep"
+
+	// UNMANAGED_FIELDS_ANNOTATION lists the JSON pointers of the fields of a CR that are managed in Control Plane only
+	UNMANAGED_FIELDS_ANNOTATION = "cpln.io/unmanaged-fields"
+
+	// Annotations set by the Argo CD resource actions
+	PAUSED_ANNOTATION                 = "cpln.io/paused"
+	RECONCILE_REQUESTED_AT_ANNOTATION = "cpln.io/reconcile-requested-at"
+	RESTART_REQUESTED_AT_ANNOTATION   = "cpln.io/restart-requested-at"
+	RUN_NOW_REQUESTED_AT_ANNOTATION   = "cpln.io/run-now-requested-at"
+	SUSPEND_ANNOTATION                = "cpln.io/suspend"
+
 	SPECIAL_SECRET_DATA_KEY = "value"
+
+	STATUS_MODE_CHILDREN = "children"
+	STATUS_MODE_SUMMARY  = "summary"
 )
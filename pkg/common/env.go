@@ -2,6 +2,7 @@ package common
 
 import (
 	"math"
+	"math/rand/v2"
 	"os"
 	"strconv"
 	"strings"
@@ -87,3 +88,11 @@ var backOffMax = float64(GetEnvInt("EXPONENTIAL_BACKOFF_MAX", 30))
 func GetRetryDuration(numRetries int) time.Duration {
 	return time.Duration(math.Min(backOffMax, math.Pow(backOffBase, float64(numRetries)))) * time.Second
 }
+
+// GetJitteredRetryDuration adds up to 20% of jitter to GetRetryDuration, so that resources that failed together don't
+// retry together. The result is never shorter than minimum.
+func GetJitteredRetryDuration(numRetries int, minimum time.Duration) time.Duration {
+	d := GetRetryDuration(numRetries)
+	d += time.Duration(rand.Int64N(int64(d)/5 + 1))
+	return max(d, minimum)
+}
@@ -3,8 +3,88 @@ package common
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
 )
 
 var DependentResourceErr = errors.New("resource deletion failed. Another resource depends on this one. Deletion will be retried later")
 
 var NotFoundError = fmt.Errorf("cpln resource not found")
+
+// APIError is returned when the Control Plane API responds with an error status
+type APIError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Body       string
+	// RetryAfter is the delay requested by the Retry-After header of the response, if any
+	RetryAfter time.Duration
+}
+
+func NewAPIError(method string, url string, resp *http.Response, body []byte) *APIError {
+	e := &APIError{
+		Method:     method,
+		URL:        url,
+		StatusCode: resp.StatusCode,
+		Body:       string(body),
+	}
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+		e.RetryAfter = time.Duration(seconds) * time.Second
+	}
+	return e
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s %s -> status %d: %s", e.Method, e.URL, e.StatusCode, e.Body)
+}
+
+// Sync error classes. They are used as the reason of the Synced condition.
+const (
+	ErrorClassValidation  = "ValidationFailed"
+	ErrorClassAuth        = "Unauthorized"
+	ErrorClassNotFound    = "NotFound"
+	ErrorClassDependency  = "DependencyNotReady"
+	ErrorClassRateLimited = "RateLimited"
+	ErrorClassTransient   = "TransientError"
+)
+
+// ClassifyError sorts a sync error into one of the ErrorClass* constants. Anything that isn't recognized is treated as
+// transient, so it keeps being retried.
+func ClassifyError(err error) string {
+	if errors.Is(err, NotFoundError) {
+		return ErrorClassNotFound
+	}
+	if errors.Is(err, DependentResourceErr) {
+		return ErrorClassDependency
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch {
+		case apiErr.StatusCode == http.StatusTooManyRequests:
+			return ErrorClassRateLimited
+		case apiErr.StatusCode == http.StatusUnauthorized, apiErr.StatusCode == http.StatusForbidden:
+			return ErrorClassAuth
+		case apiErr.StatusCode == http.StatusNotFound:
+			return ErrorClassNotFound
+		case apiErr.StatusCode == http.StatusConflict:
+			return ErrorClassDependency
+		case apiErr.StatusCode == http.StatusBadRequest, apiErr.StatusCode == http.StatusUnprocessableEntity:
+			// A resource that references one that doesn't exist yet (e.g. a workload and its identity, applied by Argo in
+			// the same sync) is rejected as invalid, but will be accepted once the reference exists
+			body := strings.ToLower(apiErr.Body)
+			if strings.Contains(body, "not found") || strings.Contains(body, "does not exist") {
+				return ErrorClassDependency
+			}
+			return ErrorClassValidation
+		}
+	}
+	// Network errors, timeouts and 5xx responses
+	return ErrorClassTransient
+}
+
+// IsPermanentError reports whether errors of the class can only be fixed by changing the resource
+func IsPermanentError(class string) bool {
+	return class == ErrorClassValidation
+}
@@ -0,0 +1,59 @@
+package common_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+)
+
+func TestClassifyError(t *testing.T) {
+	apiErr := func(status int, body string) error {
+		return &common.APIError{Method: http.MethodPut, URL: "https://api.cpln.io/org/test/gvc/test/workload/test", StatusCode: status, Body: body}
+	}
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"invalid spec", apiErr(http.StatusBadRequest, `{"message":"spec.containers is required"}`), common.ErrorClassValidation},
+		{"missing reference", apiErr(http.StatusBadRequest, `{"message":"identity test not found"}`), common.ErrorClassDependency},
+		{"conflict", apiErr(http.StatusConflict, ""), common.ErrorClassDependency},
+		{"bad token", apiErr(http.StatusUnauthorized, ""), common.ErrorClassAuth},
+		{"no permission", apiErr(http.StatusForbidden, ""), common.ErrorClassAuth},
+		{"missing gvc", apiErr(http.StatusNotFound, ""), common.ErrorClassNotFound},
+		{"throttled", apiErr(http.StatusTooManyRequests, ""), common.ErrorClassRateLimited},
+		{"server error", apiErr(http.StatusBadGateway, ""), common.ErrorClassTransient},
+		{"wrapped", fmt.Errorf("sync: %w", apiErr(http.StatusBadRequest, "")), common.ErrorClassValidation},
+		{"not found", common.NotFoundError, common.ErrorClassNotFound},
+		{"dependent resource", common.DependentResourceErr, common.ErrorClassDependency},
+		{"network", errors.New("dial tcp: connection refused"), common.ErrorClassTransient},
+	}
+	for _, tt := range tests {
+		if got := common.ClassifyError(tt.err); got != tt.want {
+			t.Errorf("%s: ClassifyError = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+	if !common.IsPermanentError(common.ErrorClassValidation) || common.IsPermanentError(common.ErrorClassTransient) {
+		t.Errorf("only validation errors should be permanent")
+	}
+}
+
+func TestGetJitteredRetryDuration(t *testing.T) {
+	// Scenario: Jitter adds at most 20% to the exponential backoff.
+	base := common.GetRetryDuration(3)
+	for i := 0; i < 100; i++ {
+		d := common.GetJitteredRetryDuration(3, 0)
+		if d < base || d > base+base/5 {
+			t.Fatalf("GetJitteredRetryDuration(3) = %s, want between %s and %s", d, base, base+base/5)
+		}
+	}
+
+	// Scenario: A longer Retry-After wins over the backoff.
+	if d := common.GetJitteredRetryDuration(0, time.Minute); d != time.Minute {
+		t.Errorf("GetJitteredRetryDuration with Retry-After = %s, want 1m", d)
+	}
+}
@@ -0,0 +1,103 @@
+package controllers
+
+import (
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// actionAnnotations are set by the Argo CD resource actions (see scripts/actions). A change to any of them makes the
+// next reconcile push the CR, even though its generation didn't change. The values that were acted on are recorded in
+// status.operator.appliedActions, so timestamped requests run once per timestamp.
+var actionAnnotations = []string{
+	common.RECONCILE_REQUESTED_AT_ANNOTATION,
+	common.RESTART_REQUESTED_AT_ANNOTATION,
+	common.RUN_NOW_REQUESTED_AT_ANNOTATION,
+	common.SUSPEND_ANNOTATION,
+}
+
+const (
+	ConditionPaused = "Paused"
+
+	ReasonPausedByAnnotation = "PausedByAnnotation"
+	ReasonMaintenance        = "Maintenance"
+)
+
+// isPaused reports whether syncing the CR with Control Plane has been paused
+func isPaused(cr *unstructured.Unstructured) bool {
+	return cr.GetAnnotations()[common.PAUSED_ANNOTATION] == "true"
+}
+
+// pauseReason returns why syncing the CR is paused, or an empty string if it isn't
+func (r *controller) pauseReason(cr *unstructured.Unstructured) string {
+	if r.opts.Maintenance {
+		return ReasonMaintenance
+	}
+	if isPaused(cr) {
+		return ReasonPausedByAnnotation
+	}
+	return ""
+}
+
+// requestedActions returns the action annotations set on the CR
+func requestedActions(cr *unstructured.Unstructured) map[string]any {
+	requested := map[string]any{}
+	annotations := cr.GetAnnotations()
+	for _, a := range actionAnnotations {
+		if v, ok := annotations[a]; ok {
+			requested[a] = v
+		}
+	}
+	return requested
+}
+
+func appliedActions(cr *unstructured.Unstructured) map[string]any {
+	applied, _ := operatorStatus(cr)["appliedActions"].(map[string]any)
+	return applied
+}
+
+// actionRequested returns the value of an action annotation, and whether that value hasn't been acted on yet
+func actionRequested(cr *unstructured.Unstructured, annotation string) (string, bool) {
+	v, ok := cr.GetAnnotations()[annotation]
+	if !ok {
+		return "", false
+	}
+	applied, _ := appliedActions(cr)[annotation].(string)
+	return v, v != applied
+}
+
+// reconcileRequested reports whether a reconcile was requested since the last one that reset the backoff, and records
+// the request as handled. The push it leads to may still fail, and is then retried with backoff like any other.
+func reconcileRequested(cr *unstructured.Unstructured) bool {
+	v, ok := actionRequested(cr, common.RECONCILE_REQUESTED_AT_ANNOTATION)
+	o := operatorStatus(cr)
+	if !ok || o["reconcileRequestedAt"] == v {
+		return false
+	}
+	o["reconcileRequestedAt"] = v
+	return true
+}
+
+// actionsChanged reports whether any action annotation changed since the CR was last pushed
+func actionsChanged(cr *unstructured.Unstructured) bool {
+	requested := requestedActions(cr)
+	applied := appliedActions(cr)
+	if len(requested) != len(applied) {
+		return true
+	}
+	for k, v := range requested {
+		if applied[k] != v {
+			return true
+		}
+	}
+	return false
+}
+
+// recordActions marks the action annotations of the CR as applied
+func recordActions(cr *unstructured.Unstructured) {
+	o := operatorStatus(cr)
+	if requested := requestedActions(cr); len(requested) > 0 {
+		o["appliedActions"] = requested
+	} else {
+		delete(o, "appliedActions")
+	}
+}
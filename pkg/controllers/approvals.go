@@ -0,0 +1,99 @@
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ConditionPendingApproval is set to True while the changes to a CR in a namespace that requires approval wait for
+	// their plan to be approved
+	ConditionPendingApproval = "PendingApproval"
+
+	ReasonAwaitingApproval   = "AwaitingApproval"
+	ReasonStaleApproval      = "StaleApproval"
+	ReasonUnrecordedApprover = "UnrecordedApprover"
+	ReasonSelfApproval       = "SelfApproval"
+)
+
+// approvalRequired reports whether the namespace of the CR requires its changes to be approved before they are pushed
+func (r *controller) approvalRequired(ctx cpln.Context, cr *unstructured.Unstructured) (bool, error) {
+	ns := &corev1.Namespace{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: cr.GetNamespace()}, ns); err != nil {
+		return false, err
+	}
+	return ns.Labels[common.REQUIRE_APPROVAL_LABEL] == "true", nil
+}
+
+// push pushes the CR to Control Plane. In namespaces that require approval, the changes are planned first, and only
+// pushed once the cpln.io/approved-plan annotation matches the hash of the plan. The plan is computed again right before
+// the push, so an approval goes stale when either the CR or the Control Plane resource changes in the meantime.
+func (r *controller) push(ctx cpln.Context, log logr.Logger, cr *unstructured.Unstructured) (ctrl.Result, error) {
+	if r.remoteDeletionHeld(cr) {
+		log.Info("Resource was deleted in Control Plane, not pushing until the CR is deleted or switched to recreate")
+		return r.defaultResult(), nil
+	}
+	if r.opts.Shadow {
+		return r.shadowPush(ctx, log, cr)
+	}
+	required, err := r.approvalRequired(ctx, cr)
+	if err != nil {
+		log.Error(err, "Failed to check whether the namespace requires approval")
+		return zeroResult, err
+	}
+	if !required {
+		return r.syncFromK8sToCpln(ctx, log, cr)
+	}
+
+	p, err := r.computePlan(ctx, log, cr)
+	if err != nil {
+		return zeroResult, err
+	}
+	hash := planHash(generation(cr), p)
+	annotations := cr.GetAnnotations()
+	approved := annotations[common.APPROVED_PLAN_ANNOTATION]
+	approvedBy := annotations[common.APPROVED_BY_ANNOTATION]
+	//Nothing to approve when the push changes nothing, e.g. when only an action was requested
+	if p == noChanges || (approved == hash && approvedBy != "" && approvedBy != annotations[common.CHANGED_BY_ANNOTATION]) {
+		log.Info("Changes approved, pushing", "planHash", hash, "approvedBy", approvedBy)
+		removeCondition(cr, ConditionPendingApproval)
+		if p != noChanges {
+			operatorStatus(cr)["approvedBy"] = approvedBy
+		}
+		return r.syncFromK8sToCpln(ctx, log, cr)
+	}
+
+	reason := ReasonAwaitingApproval
+	message := fmt.Sprintf("Set the %s annotation to %s to push the changes in status.operator.plan.",
+		common.APPROVED_PLAN_ANNOTATION, hash)
+	switch {
+	case approved != hash && approved != "":
+		reason = ReasonStaleApproval
+		message = fmt.Sprintf("The approved plan %s is out of date. Set the %s annotation to %s to push the changes in status.operator.plan.",
+			approved, common.APPROVED_PLAN_ANNOTATION, hash)
+	//The webhook records every approver, so an approval without one bypassed it, e.g. in a namespace it skips
+	case approved == hash && approvedBy == "":
+		reason = ReasonUnrecordedApprover
+		message = fmt.Sprintf("The webhook didn't record who approved the plan in %s, so the approval can't be checked. Approve it again where the webhook applies.",
+			common.APPROVED_BY_ANNOTATION)
+	case approved == hash:
+		reason = ReasonSelfApproval
+		message = fmt.Sprintf("%s changed the resource, so they can't approve the change. Someone else has to set the %s annotation.",
+			approvedBy, common.APPROVED_PLAN_ANNOTATION)
+	}
+	log.Info("Changes are waiting for approval", "reason", reason, "planHash", hash)
+	before := cr.DeepCopy()
+	recordPlan(cr, p)
+	setCondition(cr, ConditionPendingApproval, "True", reason, message)
+	if err := r.writeChangedStatus(ctx, log, before, cr); err != nil {
+		return zeroResult, err
+	}
+	return r.defaultResult(), nil
+}
@@ -0,0 +1,106 @@
+package controllers_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/controllers"
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestApprovalGate(t *testing.T) {
+	pushes := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("dryRun") != "true" {
+			pushes++
+		}
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+	ctx := context.Background()
+	gvk := schema.GroupVersionKind{Group: common.API_GROUP, Version: common.API_REVISION, Kind: common.KIND_WORKLOAD}
+	opts := controllers.Options{
+		APIURL: server.URL,
+		Credentials: cpln.CredentialProviderFunc(func(context.Context, string) (string, error) {
+			return "token", nil
+		}),
+	}
+	cr := &unstructured.Unstructured{Object: map[string]any{"org": "acme", "gvc": "main", "spec": map[string]any{"image": "api:2"}}}
+	cr.SetGroupVersionKind(gvk)
+	cr.SetName("api")
+	cr.SetNamespace("prod")
+	cr.SetGeneration(1)
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{common.REQUIRE_APPROVAL_LABEL: "true"}}}
+	k8s := fake.NewClientBuilder().WithObjects(cr, ns).WithStatusSubresource(cr).Build()
+	key := client.ObjectKeyFromObject(cr)
+	reconcile := func(annotations map[string]string) (reason, planHash string) {
+		t.Helper()
+		stored := &unstructured.Unstructured{}
+		stored.SetGroupVersionKind(gvk)
+		if err := k8s.Get(ctx, key, stored); err != nil {
+			t.Fatal(err)
+		}
+		if annotations != nil {
+			stored.SetAnnotations(annotations)
+			if err := k8s.Update(ctx, stored); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, err := controllers.NewReconciler(k8s, opts, gvk).Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("reconcile failed: %v", err)
+		}
+		if err := k8s.Get(ctx, key, stored); err != nil {
+			t.Fatal(err)
+		}
+		conditions, _, _ := unstructured.NestedSlice(stored.Object, "status", "conditions")
+		for _, c := range conditions {
+			if c := c.(map[string]any); c["type"] == controllers.ConditionPendingApproval {
+				reason, _ = c["reason"].(string)
+			}
+		}
+		planHash, _, _ = unstructured.NestedString(stored.Object, "status", "operator", "planHash")
+		return reason, planHash
+	}
+
+	// Scenario: Changes wait for an approval of their plan.
+	reason, hash := reconcile(nil)
+	if reason != controllers.ReasonAwaitingApproval || hash == "" {
+		t.Fatalf("reason = %q, plan hash %q, want AwaitingApproval with a plan", reason, hash)
+	}
+
+	// Scenario: An approval the webhook didn't record an approver for is held.
+	if reason, _ := reconcile(map[string]string{common.APPROVED_PLAN_ANNOTATION: hash}); reason != controllers.ReasonUnrecordedApprover {
+		t.Errorf("reason = %q, want UnrecordedApprover", reason)
+	}
+
+	// Scenario: The author of the change can't approve it.
+	self := map[string]string{common.APPROVED_PLAN_ANNOTATION: hash, common.APPROVED_BY_ANNOTATION: "alice", common.CHANGED_BY_ANNOTATION: "alice"}
+	if reason, _ := reconcile(self); reason != controllers.ReasonSelfApproval {
+		t.Errorf("reason = %q, want SelfApproval", reason)
+	}
+	if pushes != 0 {
+		t.Errorf("pushes = %d before a valid approval, want none", pushes)
+	}
+
+	// Scenario: An approval by someone else pushes the change.
+	other := map[string]string{common.APPROVED_PLAN_ANNOTATION: hash, common.APPROVED_BY_ANNOTATION: "bob", common.CHANGED_BY_ANNOTATION: "alice"}
+	if reason, _ := reconcile(other); reason != "" || pushes != 1 {
+		t.Errorf("reason = %q, pushes = %d, want the change pushed once", reason, pushes)
+	}
+}
@@ -0,0 +1,222 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	"github.com/controlplane-com/k8s-operator/pkg/realtime"
+	"github.com/controlplane-com/k8s-operator/pkg/websocket"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// remoteIndex indexes CRs by the org, gvc and name of their Control Plane resource
+const remoteIndex = "cpln.io/remote"
+
+func remoteKey(org, gvc, name string) string {
+	return fmt.Sprintf("%s/%s/%s", org, gvc, name)
+}
+
+func indexRemote(obj client.Object) []string {
+	cr, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+	org, _ := cr.Object["org"].(string)
+	gvc, _ := cr.Object["gvc"].(string)
+	return []string{remoteKey(org, gvc, cpln.Name(cr))}
+}
+
+// changeQueueSize is how many queued CRs the channel of a controller holds. The change feed drops changes that don't
+// fit rather than blocking its connection, and leaves them to polling.
+const changeQueueSize = 1024
+
+func changeFeedSyncName(org string) string {
+	return fmt.Sprintf("changes.%s", org)
+}
+
+// changeFeed follows the Control Plane changes of every org with managed resources, and sends the CRs of the resources
+// that changed to their controllers, which pull them right away. Polling goes on as before, so changes missed while
+// the feed is disconnected are still picked up.
+type changeFeed struct {
+	reader client.Reader
+	opts   Options
+	syncs  *realtime.Registry
+	m      sync.Mutex
+	// kinds maps the lowercase kind of a Control Plane resource to the controller of its CRs
+	kinds map[string]changeSource
+	// subscribers maps each followed org to the CRs that need it, and orgs maps each of those CRs back to its org
+	subscribers map[string]map[string]bool
+	orgs        map[string]string
+}
+
+type changeSource struct {
+	gvk    schema.GroupVersionKind
+	events chan<- event.GenericEvent
+}
+
+func newChangeFeed(reader client.Reader, opts Options, syncs *realtime.Registry) *changeFeed {
+	return &changeFeed{
+		reader:      reader,
+		opts:        opts,
+		syncs:       syncs,
+		kinds:       map[string]changeSource{},
+		subscribers: map[string]map[string]bool{},
+		orgs:        map[string]string{},
+	}
+}
+
+// watch sends the CRs of the kind to events when their resource changes. The CRs must be indexed with indexRemote.
+func (f *changeFeed) watch(gvk schema.GroupVersionKind, events chan<- event.GenericEvent) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.kinds[strings.ToLower(gvk.Kind)] = changeSource{gvk: gvk, events: events}
+}
+
+func changeSubscriber(kind string, key types.NamespacedName) string {
+	return fmt.Sprintf("%s/%s", strings.ToLower(kind), key)
+}
+
+// subscribe starts following the changes of the org of the CR, unless that's already done. The org is followed until
+// its last CR is released.
+func (f *changeFeed) subscribe(ctx cpln.Context, cr client.Object) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	org := ctx.Org()
+	subscriber := changeSubscriber(cr.GetObjectKind().GroupVersionKind().Kind, client.ObjectKeyFromObject(cr))
+	//A CR moved to another org releases the previous one
+	if previous, ok := f.orgs[subscriber]; ok && previous != org {
+		if err := f.unsubscribe(subscriber); err != nil {
+			return err
+		}
+	}
+	fullName := changeFeedSyncName(org)
+	if f.syncs.GetSync(fullName) == nil {
+		if err := f.connect(org); err != nil {
+			return err
+		}
+	}
+	if f.subscribers[org] == nil {
+		f.subscribers[org] = map[string]bool{}
+	}
+	f.subscribers[org][subscriber] = true
+	f.orgs[subscriber] = org
+	return nil
+}
+
+// release stops following the org of the CR once no other CR needs it
+func (f *changeFeed) release(kind string, key types.NamespacedName) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return f.unsubscribe(changeSubscriber(kind, key))
+}
+
+func (f *changeFeed) unsubscribe(subscriber string) error {
+	org, ok := f.orgs[subscriber]
+	if !ok {
+		return nil
+	}
+	delete(f.orgs, subscriber)
+	delete(f.subscribers[org], subscriber)
+	if len(f.subscribers[org]) > 0 {
+		return nil
+	}
+	delete(f.subscribers, org)
+	return f.syncs.DeregisterSync(changeFeedSyncName(org))
+}
+
+// connect opens the feed of the org. Each dial fetches a fresh token, so reconnects survive token rotation.
+func (f *changeFeed) connect(org string) error {
+	background := context.Background()
+	l := log.FromContext(background).WithValues("org", org)
+
+	//The connect handler runs right after each dial, so it registers with the token that dial used
+	var token string
+	tokens := func() (string, error) {
+		var err error
+		token, err = f.opts.Credentials.Token(background, org)
+		return token, err
+	}
+	messageHandler := func(message []byte) error {
+		f.handle(background, l, message)
+		return nil
+	}
+	connectHandler := func(w websocket.Client) error {
+		return registerInterest(w, token, Interest{Org: org})
+	}
+
+	w, err := websocket.NewClientWithTokenSource(background, l, f.opts.ChangeFeedURL, tokens, f.opts.RealtimeReconnectDelay, messageHandler, connectHandler)
+	if err != nil {
+		return err
+	}
+	f.syncs.RegisterSync(changeFeedSyncName(org), w)
+	return nil
+}
+
+// handle sends the CRs of the resource a message is about to their controller. Messages that can't be handled are
+// logged and skipped, so they don't drop the connection. It never blocks the connection on a full queue.
+func (f *changeFeed) handle(ctx context.Context, l logr.Logger, message []byte) {
+	var change realtime.Message[realtime.ResourceChange]
+	if err := json.Unmarshal(message, &change); err != nil {
+		l.Error(err, "Got a malformed change feed message")
+		return
+	}
+	org, gvc, kind, name, ok := parseResourceLink(change.Data.Link)
+	if !ok {
+		return
+	}
+	f.m.Lock()
+	source, ok := f.kinds[kind]
+	f.m.Unlock()
+	if !ok {
+		return
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(source.gvk.GroupVersion().WithKind(source.gvk.Kind + "List"))
+	if err := f.reader.List(ctx, list, client.MatchingFields{remoteIndex: remoteKey(org, gvc, name)}); err != nil {
+		l.Error(err, "Failed to find the resources of a change", "link", change.Data.Link)
+		return
+	}
+	for i := range list.Items {
+		cr := &list.Items[i]
+		if !f.opts.Manages(cr) {
+			continue
+		}
+		l.Info("Resource changed on Control Plane, pulling it", "link", change.Data.Link, "eventType", change.EventType)
+		select {
+		case source.events <- event.GenericEvent{Object: cr}:
+		default:
+			l.Info("Change queue is full, leaving the change to polling", "link", change.Data.Link)
+		}
+	}
+}
+
+// parseResourceLink splits the self link of an org or gvc level Control Plane resource. Links to anything nested
+// deeper, like workload deployments, are not handled.
+func parseResourceLink(link string) (org, gvc, kind, name string, ok bool) {
+	link, _, _ = strings.Cut(link, "?")
+	parts := strings.Split(strings.Trim(link, "/"), "/")
+	if len(parts) < 4 || parts[0] != "org" {
+		return "", "", "", "", false
+	}
+	org = parts[1]
+	parts = parts[2:]
+	switch {
+	case len(parts) == 2:
+		return org, "", parts[0], parts[1], true
+	case len(parts) == 4 && parts[0] == "gvc":
+		return org, parts[1], parts[2], parts[3], true
+	default:
+		return "", "", "", "", false
+	}
+}
@@ -0,0 +1,261 @@
+package controllers_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/controllers"
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	"github.com/gorilla/websocket"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestChangeFeedEnqueuesChangedResources(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: common.API_GROUP, Version: common.API_REVISION, Kind: common.KIND_WORKLOAD}
+	newCR := func(namespace, name, gvc string) *unstructured.Unstructured {
+		cr := &unstructured.Unstructured{Object: map[string]any{"org": "acme", "gvc": gvc}}
+		cr.SetGroupVersionKind(gvk)
+		cr.SetNamespace(namespace)
+		cr.SetName(name)
+		return cr
+	}
+	renamed := newCR("default", "api-cr", "main")
+	renamed.SetAnnotations(map[string]string{"cpln.io/name-replacement": "api"})
+	indexed := &unstructured.Unstructured{}
+	indexed.SetGroupVersionKind(gvk)
+	reader := fake.NewClientBuilder().
+		WithObjects(newCR("default", "web", "main"), newCR("dev", "web", "dev"), renamed, newCR("excluded", "web", "main")).
+		WithIndex(indexed, controllers.RemoteIndex, controllers.IndexRemote).
+		Build()
+
+	// The stand-in event server checks the registration, then streams the changes the test expects to be handled,
+	// mixed with ones it expects to be skipped
+	registered := make(chan controllers.RegisterInterestRequest, 1)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		var request controllers.RegisterInterestRequest
+		if err := conn.ReadJSON(&request); err != nil {
+			return
+		}
+		registered <- request
+		for _, message := range []string{
+			`not json`,
+			`{"eventType": "updated", "data": {"link": "/org/acme/gvc/main/identity/web"}}`,
+			`{"eventType": "updated", "data": {"link": "/org/acme/gvc/main/workload/web/deployment/aws-us-west-2"}}`,
+			`{"eventType": "updated", "data": {"link": "/org/acme/gvc/main/workload/web"}}`,
+			`{"eventType": "deleted", "data": {"link": "/org/acme/gvc/main/workload/api"}}`,
+		} {
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(message)); err != nil {
+				return
+			}
+		}
+		_, _, _ = conn.ReadMessage()
+	}))
+	defer server.Close()
+
+	feed := controllers.WatchChanges(reader, controllers.Options{
+		ChangeFeedURL:          "ws" + strings.TrimPrefix(server.URL, "http"),
+		ExcludedNamespaces:     []string{"excluded"},
+		RealtimeReconnectDelay: 10 * time.Millisecond,
+		Credentials: cpln.CredentialProviderFunc(func(context.Context, string) (string, error) {
+			return "token", nil
+		}),
+	}, gvk)
+	defer func() { _ = feed.Syncs.Close() }()
+	ctx := cpln.NewContext(context.Background(), "acme", "main", "token")
+	if err := feed.Subscribe(ctx, newCR("default", "web", "main")); err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+	events := feed.Events
+
+	// Scenario: The feed registers for the changes of the org with its token.
+	select {
+	case request := <-registered:
+		b, _ := json.Marshal(request)
+		if request.Token != "token" || len(request.Interests) != 1 || request.Interests[0].Org != "acme" {
+			t.Errorf("registration = %s, want the token and the acme org", b)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the feed never registered")
+	}
+
+	// Scenario: Changes to resources with a CR queue that CR, matched by org, gvc and Control Plane name. Malformed
+	// messages, other kinds, nested resources and unmanaged namespaces are skipped.
+	var got []string
+	for len(got) < 2 {
+		select {
+		case e := <-events:
+			got = append(got, e.Object.GetNamespace()+"/"+e.Object.GetName())
+		case <-time.After(5 * time.Second):
+			t.Fatalf("got %v, want default/web and default/api-cr", got)
+		}
+	}
+	if got[0] != "default/web" || got[1] != "default/api-cr" {
+		t.Errorf("got %v, want default/web and default/api-cr", got)
+	}
+	select {
+	case e := <-events:
+		t.Errorf("unexpected change for %s/%s", e.Object.GetNamespace(), e.Object.GetName())
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestChangeFeedSubscriptions(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: common.API_GROUP, Version: common.API_REVISION, Kind: common.KIND_WORKLOAD}
+	newCR := func(name string) *unstructured.Unstructured {
+		cr := &unstructured.Unstructured{Object: map[string]any{"org": "acme", "gvc": "main"}}
+		cr.SetGroupVersionKind(gvk)
+		cr.SetNamespace("default")
+		cr.SetName(name)
+		return cr
+	}
+
+	// The stand-in event server records the token of each connection, and drops the first one after its registration
+	type connection struct {
+		header, registered string
+	}
+	connections := make(chan connection, 10)
+	upgrader := websocket.Upgrader{}
+	var dials atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		var request controllers.RegisterInterestRequest
+		if err := conn.ReadJSON(&request); err != nil {
+			return
+		}
+		connections <- connection{header: r.Header.Get("Authorization"), registered: request.Token}
+		if dials.Add(1) == 1 {
+			return
+		}
+		_, _, _ = conn.ReadMessage()
+	}))
+	defer server.Close()
+
+	var tokens atomic.Int32
+	feed := controllers.WatchChanges(fake.NewClientBuilder().Build(), controllers.Options{
+		ChangeFeedURL:          "ws" + strings.TrimPrefix(server.URL, "http"),
+		RealtimeReconnectDelay: 10 * time.Millisecond,
+		Credentials: cpln.CredentialProviderFunc(func(context.Context, string) (string, error) {
+			return fmt.Sprintf("token-%d", tokens.Add(1)), nil
+		}),
+	}, gvk)
+	defer func() { _ = feed.Syncs.Close() }()
+	ctx := cpln.NewContext(context.Background(), "acme", "main", "stale")
+	for _, name := range []string{"web", "api"} {
+		if err := feed.Subscribe(ctx, newCR(name)); err != nil {
+			t.Fatalf("subscribe failed: %v", err)
+		}
+	}
+
+	// Scenario: Each dial fetches a fresh token, used for both the connection and the registration.
+	for i := 1; i <= 2; i++ {
+		select {
+		case c := <-connections:
+			want := fmt.Sprintf("token-%d", i)
+			if c.header != "Bearer "+want || c.registered != want {
+				t.Errorf("connection %d used %q and registered %q, want %s", i, c.header, c.registered, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("connection %d never registered", i)
+		}
+	}
+
+	// Scenario: The org is followed until its last CR is released.
+	if err := feed.Release(newCR("web")); err != nil {
+		t.Fatalf("release failed: %v", err)
+	}
+	if feed.Syncs.GetSync("changes.acme") == nil {
+		t.Error("the org stopped being followed while api still needs it")
+	}
+	if err := feed.Release(newCR("api")); err != nil {
+		t.Fatalf("release failed: %v", err)
+	}
+	if feed.Syncs.GetSync("changes.acme") != nil {
+		t.Error("the org is still followed after its last CR was released")
+	}
+}
+
+func TestChangeFeedFullQueue(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: common.API_GROUP, Version: common.API_REVISION, Kind: common.KIND_WORKLOAD}
+	cr := &unstructured.Unstructured{Object: map[string]any{"org": "acme", "gvc": "main"}}
+	cr.SetGroupVersionKind(gvk)
+	cr.SetNamespace("default")
+	cr.SetName("web")
+	indexed := &unstructured.Unstructured{}
+	indexed.SetGroupVersionKind(gvk)
+	reader := fake.NewClientBuilder().
+		WithObjects(cr).
+		WithIndex(indexed, controllers.RemoteIndex, controllers.IndexRemote).
+		Build()
+
+	// The stand-in event server streams more changes than the queue holds, then a ping the test waits for
+	change := `{"eventType": "updated", "data": {"link": "/org/acme/gvc/main/workload/web"}}`
+	pong := make(chan struct{})
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		conn.SetPongHandler(func(string) error {
+			close(pong)
+			return nil
+		})
+		var request controllers.RegisterInterestRequest
+		if err := conn.ReadJSON(&request); err != nil {
+			return
+		}
+		for i := 0; i < controllers.ChangeQueueSize+10; i++ {
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(change)); err != nil {
+				return
+			}
+		}
+		if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+			return
+		}
+		_, _, _ = conn.ReadMessage()
+	}))
+	defer server.Close()
+
+	feed := controllers.WatchChanges(reader, controllers.Options{
+		ChangeFeedURL:          "ws" + strings.TrimPrefix(server.URL, "http"),
+		RealtimeReconnectDelay: 10 * time.Millisecond,
+		Credentials: cpln.CredentialProviderFunc(func(context.Context, string) (string, error) {
+			return "token", nil
+		}),
+	}, gvk)
+	defer func() { _ = feed.Syncs.Close() }()
+	if err := feed.Subscribe(cpln.NewContext(context.Background(), "acme", "main", "token"), cr); err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+
+	// Scenario: Nobody drains the queue, yet the connection keeps reading past it, dropping the changes that don't fit.
+	select {
+	case <-pong:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the connection blocked on the full queue")
+	}
+	if len(feed.Events) != controllers.ChangeQueueSize {
+		t.Errorf("queued %d changes, want %d", len(feed.Events), controllers.ChangeQueueSize)
+	}
+}
@@ -0,0 +1,181 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// ConditionConflict is set to True while a push is held because the Control Plane resource changed since the last
+	// sync
+	ConditionConflict = "Conflict"
+
+	ReasonRemoteChanged      = "RemoteChanged"
+	ReasonOverlappingChanges = "OverlappingChanges"
+)
+
+// conflictPolicy returns how a push handles changes made in Control Plane since the last sync
+func (r *controller) conflictPolicy(cr *unstructured.Unstructured) string {
+	if policy := cr.GetAnnotations()[common.CONFLICT_POLICY_ANNOTATION]; policy != "" {
+		return policy
+	}
+	return r.opts.ConflictPolicy
+}
+
+// recordRemote stores the version of the Control Plane resource the CR was synced with. Unless changes are overwritten,
+// the resource itself is stored too, as the base of a later merge or comparison. Secrets are never stored, since their
+// status is readable by more users than their data.
+func (r *controller) recordRemote(cr *unstructured.Unstructured, resource map[string]any) {
+	recordRemoteVersion(cr, resource)
+	o := operatorStatus(cr)
+	if r.conflictPolicy(cr) == common.CONFLICT_POLICY_OVERWRITE || r.gvk == common.NativeSecretGVK {
+		delete(o, "mergeBase")
+		return
+	}
+	base, err := json.Marshal(withoutIgnoredFields(resource))
+	if err != nil {
+		delete(o, "mergeBase")
+		return
+	}
+	o["mergeBase"] = string(base)
+}
+
+// resolveConflicts returns the CR to push. When the Control Plane resource changed since the last sync, the push would
+// overwrite those changes, so it's held with a Conflict condition, and nil is returned. A CR that already includes
+// those changes is pushed as is. With the merge policy, changes that don't overlap with those of the CR are merged into
+// the returned CR instead. The cpln.io/overwrite-version annotation lets a push overwrite the given version. The remote
+// resource is nil when it doesn't exist.
+func (r *controller) resolveConflicts(ctx cpln.Context, log logr.Logger, cr *unstructured.Unstructured, remote map[string]any) (*unstructured.Unstructured, error) {
+	policy := r.conflictPolicy(cr)
+	recorded, ok := remoteVersion(cr)
+	//Without a remote resource, there is nothing to overwrite, and the push creates it again
+	if !ok || policy == common.CONFLICT_POLICY_OVERWRITE || remote == nil {
+		removeCondition(cr, ConditionConflict)
+		return cr, nil
+	}
+	v, _ := remote["version"].(float64)
+	version := int64(v)
+	if version == recorded || cr.GetAnnotations()[common.OVERWRITE_VERSION_ANNOTATION] == strconv.FormatInt(version, 10) {
+		removeCondition(cr, ConditionConflict)
+		return cr, nil
+	}
+
+	reason := ReasonRemoteChanged
+	message := fmt.Sprintf("Control Plane resource changed since the last sync (version %d, last synced %d).", version, recorded)
+	merged, overlapping, err := r.merge(ctx, cr, remote)
+	if err != nil {
+		return nil, err
+	}
+	if merged == cr {
+		log.Info("The CR already includes the changes made in Control Plane since the last sync", "version", version, "lastSynced", recorded)
+		removeCondition(cr, ConditionConflict)
+		return cr, nil
+	}
+	if merged != nil && policy == common.CONFLICT_POLICY_MERGE {
+		log.Info("Merging the changes made in Control Plane since the last sync", "version", version, "lastSynced", recorded)
+		removeCondition(cr, ConditionConflict)
+		return merged, nil
+	}
+	if len(overlapping) > 0 && policy == common.CONFLICT_POLICY_MERGE {
+		reason = ReasonOverlappingChanges
+		message = fmt.Sprintf("%s Both changed %s.", message, strings.Join(overlapping, ", "))
+	}
+	//Without a merge base, there is no telling which changes the CR lacks, so only overwriting them lets it through
+	if _, ok := operatorStatus(cr)["mergeBase"].(string); ok {
+		message = fmt.Sprintf("%s Update the resource to include those changes, or set the %s annotation to %d to overwrite them.",
+			message, common.OVERWRITE_VERSION_ANNOTATION, version)
+	} else {
+		message = fmt.Sprintf("%s Set the %s annotation to %d to overwrite them.", message, common.OVERWRITE_VERSION_ANNOTATION, version)
+	}
+	log.Info("Push held, Control Plane resource changed since the last sync", "reason", reason, "version", version, "lastSynced", recorded)
+	setCondition(cr, ConditionConflict, "True", reason, message)
+	return nil, nil
+}
+
+// merge applies the changes made in Control Plane since the last sync to a copy of the CR. It returns the CR itself when
+// it already includes them, nil and the paths changed on both sides when the changes overlap, or nil alone when no
+// merge base was recorded.
+func (r *controller) merge(ctx cpln.Context, cr *unstructured.Unstructured, remote map[string]any) (*unstructured.Unstructured, []string, error) {
+	mergeBase, _ := operatorStatus(cr)["mergeBase"].(string)
+	if mergeBase == "" {
+		return nil, nil, nil
+	}
+	base := map[string]any{}
+	if err := json.Unmarshal([]byte(mergeBase), &base); err != nil {
+		return nil, nil, nil
+	}
+	cplnObj, err := r.cplnConnector.CplnFormat(cr)
+	if err != nil {
+		return nil, nil, err
+	}
+	//Round trip through JSON, so that the CR compares equal to the resources read from Control Plane
+	b, err := json.Marshal(cplnObj)
+	if err != nil {
+		return nil, nil, err
+	}
+	local := map[string]any{}
+	if err := json.Unmarshal(b, &local); err != nil {
+		return nil, nil, err
+	}
+
+	//Unmanaged fields are taken from Control Plane when pushing, so they don't take part in the merge
+	unmanaged := r.unmanagedFields(cr)
+	base = withoutUnmanaged(base, unmanaged)
+	local = withoutUnmanaged(withoutIgnoredFields(local), unmanaged)
+	remote = withoutUnmanaged(withoutIgnoredFields(remote), unmanaged)
+	var overlapping []string
+	merged, _ := merge3(&overlapping, "", base, local, remote).(map[string]any)
+	if len(overlapping) > 0 {
+		slices.Sort(overlapping)
+		return nil, overlapping, nil
+	}
+	if reflect.DeepEqual(merged, local) {
+		return cr, nil, nil
+	}
+	mergedCR, err := r.cplnConnector.K8sFormat(ctx, cr.DeepCopy(), merged)
+	return mergedCR, nil, err
+}
+
+// merge3 merges the changes from base to local and from base to remote. Maps are merged key by key. Any other value
+// changed differently on both sides is reported in overlapping, and keeps its local value.
+func merge3(overlapping *[]string, path string, base, local, remote any) any {
+	switch {
+	case reflect.DeepEqual(local, remote), reflect.DeepEqual(base, remote):
+		return local
+	case reflect.DeepEqual(base, local):
+		return remote
+	}
+	localMap, localIsMap := local.(map[string]any)
+	remoteMap, remoteIsMap := remote.(map[string]any)
+	if !localIsMap || !remoteIsMap {
+		*overlapping = append(*overlapping, path)
+		return local
+	}
+	baseMap, _ := base.(map[string]any)
+	merged := map[string]any{}
+	keys := map[string]bool{}
+	for _, m := range []map[string]any{baseMap, localMap, remoteMap} {
+		for k := range m {
+			keys[k] = true
+		}
+	}
+	for k := range keys {
+		field := k
+		if path != "" {
+			field = path + "." + k
+		}
+		if v := merge3(overlapping, field, baseMap[k], localMap[k], remoteMap[k]); v != nil {
+			merged[k] = v
+		}
+	}
+	return merged
+}
@@ -0,0 +1,187 @@
+package controllers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/controllers"
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestResolveConflicts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"name": "api", "kind": "workload", "version": 5, "description": "console",
+			"spec": map[string]any{"image": "api:1"},
+		})
+	}))
+	defer server.Close()
+	ctx := cpln.NewContext(context.Background(), "acme", "main", "token")
+	opts := controllers.Options{APIURL: server.URL, ConflictPolicy: common.CONFLICT_POLICY_ABORT}
+	newCR := func(description, image string, remoteVersion int64, mergeBase string, annotations map[string]string) *unstructured.Unstructured {
+		cr := &unstructured.Unstructured{Object: map[string]any{
+			"org": "acme", "gvc": "main", "description": description, "spec": map[string]any{"image": image},
+		}}
+		cr.SetGroupVersionKind(schema.GroupVersionKind{Group: common.API_GROUP, Version: common.API_REVISION, Kind: common.KIND_WORKLOAD})
+		cr.SetName("api")
+		cr.SetNamespace("default")
+		cr.SetAnnotations(annotations)
+		operator := map[string]any{"remoteVersion": remoteVersion}
+		if mergeBase != "" {
+			operator["mergeBase"] = mergeBase
+		}
+		cr.Object["status"] = map[string]any{"operator": operator}
+		return cr
+	}
+	conflict := func(cr *unstructured.Unstructured) map[string]any {
+		conditions, _, _ := unstructured.NestedSlice(cr.Object, "status", "conditions")
+		for _, c := range conditions {
+			if c := c.(map[string]any); c["type"] == "Conflict" {
+				return c
+			}
+		}
+		return nil
+	}
+	base := `{"name":"api","kind":"workload","description":"old","spec":{"image":"api:1"}}`
+	merge := map[string]string{common.CONFLICT_POLICY_ANNOTATION: common.CONFLICT_POLICY_MERGE}
+
+	// Scenario: A push is sent as is when Control Plane didn't change since the last sync.
+	cr := newCR("old", "api:2", 5, "", nil)
+	if got, err := controllers.ResolveConflicts(ctx, opts, cr); err != nil || got != cr || conflict(cr) != nil {
+		t.Errorf("unchanged remote: got %v, %v, conflict %v", got, err, conflict(cr))
+	}
+
+	// Scenario: By default, a push overwrites the changes made in Control Plane, as it always did.
+	cr = newCR("old", "api:2", 4, "", nil)
+	if got, err := controllers.ResolveConflicts(ctx, controllers.Options{APIURL: server.URL}, cr); err != nil || got != cr {
+		t.Errorf("default policy: got %v, %v, want the CR pushed as is", got, err)
+	}
+
+	// Scenario: With the abort policy, a push is held when Control Plane changed since the last sync.
+	cr = newCR("old", "api:2", 4, "", nil)
+	if got, err := controllers.ResolveConflicts(ctx, opts, cr); err != nil || got != nil {
+		t.Errorf("changed remote: got %v, %v, want the push held", got, err)
+	}
+	if c := conflict(cr); c == nil || c["reason"] != "RemoteChanged" || !strings.Contains(c["message"].(string), "version 5, last synced 4") {
+		t.Errorf("conflict = %v, want RemoteChanged", c)
+	}
+	if c := conflict(cr); c != nil && strings.Contains(c["message"].(string), "Update the resource") {
+		t.Errorf("conflict = %v, advises updating the resource without a merge base to compare it with", c)
+	}
+
+	// Scenario: A held push goes through once the CR is updated to include the changes made in Control Plane.
+	cr = newCR("old", "api:2", 4, base, nil)
+	if got, err := controllers.ResolveConflicts(ctx, opts, cr); err != nil || got != nil {
+		t.Errorf("changed remote with a merge base: got %v, %v, want the push held", got, err)
+	}
+	if c := conflict(cr); c == nil || !strings.Contains(c["message"].(string), "Update the resource to include those changes") {
+		t.Errorf("conflict = %v, want the advice to update the resource", c)
+	}
+	cr.Object["description"] = "console"
+	if got, err := controllers.ResolveConflicts(ctx, opts, cr); err != nil || got != cr || conflict(cr) != nil {
+		t.Errorf("updated resource: got %v, %v, conflict %v, want the CR pushed as is", got, err, conflict(cr))
+	}
+
+	// Scenario: The held push goes through once the version is allowed to be overwritten, clearing the condition.
+	cr.SetAnnotations(map[string]string{common.OVERWRITE_VERSION_ANNOTATION: "5"})
+	if got, err := controllers.ResolveConflicts(ctx, opts, cr); err != nil || got != cr || conflict(cr) != nil {
+		t.Errorf("overwritten remote: got %v, %v, conflict %v", got, err, conflict(cr))
+	}
+
+	// Scenario: With the merge policy, changes that don't overlap are merged.
+	cr = newCR("old", "api:2", 4, base, merge)
+	got, err := controllers.ResolveConflicts(ctx, opts, cr)
+	if err != nil || got == nil {
+		t.Fatalf("merge: got %v, %v", got, err)
+	}
+	if got.Object["description"] != "console" || got.Object["spec"].(map[string]any)["image"] != "api:2" {
+		t.Errorf("merged = %v, want the console description and the new image", got.Object)
+	}
+	if cr.Object["description"] != "old" {
+		t.Errorf("the CR itself was changed by the merge")
+	}
+
+	// Scenario: Overlapping changes are held, naming the fields changed on both sides.
+	cr = newCR("mine", "api:2", 4, base, merge)
+	if got, err := controllers.ResolveConflicts(ctx, opts, cr); err != nil || got != nil {
+		t.Errorf("overlapping merge: got %v, %v, want the push held", got, err)
+	}
+	if c := conflict(cr); c == nil || c["reason"] != "OverlappingChanges" || !strings.Contains(c["message"].(string), "Both changed description.") {
+		t.Errorf("conflict = %v, want OverlappingChanges", c)
+	}
+}
+
+func TestMergedPush(t *testing.T) {
+	var pushed map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			pushed = map[string]any{}
+			_ = json.NewDecoder(r.Body).Decode(&pushed)
+			pushed["version"] = 6
+			_ = json.NewEncoder(w).Encode(pushed)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"name": "api", "kind": "workload", "version": 5, "description": "console",
+			"spec": map[string]any{"image": "api:1"},
+		})
+	}))
+	defer server.Close()
+	ctx := context.Background()
+	gvk := schema.GroupVersionKind{Group: common.API_GROUP, Version: common.API_REVISION, Kind: common.KIND_WORKLOAD}
+	opts := controllers.Options{
+		APIURL: server.URL,
+		Credentials: cpln.CredentialProviderFunc(func(context.Context, string) (string, error) {
+			return "token", nil
+		}),
+	}
+	cr := &unstructured.Unstructured{Object: map[string]any{
+		"org": "acme", "gvc": "main", "description": "old", "spec": map[string]any{"image": "api:2"},
+	}}
+	cr.SetGroupVersionKind(gvk)
+	cr.SetName("api")
+	cr.SetNamespace("default")
+	cr.SetGeneration(2)
+	cr.SetAnnotations(map[string]string{common.CONFLICT_POLICY_ANNOTATION: common.CONFLICT_POLICY_MERGE})
+	cr.Object["status"] = map[string]any{"operator": map[string]any{
+		"lastSyncedGeneration": int64(1),
+		"remoteVersion":        int64(4),
+		"mergeBase":            `{"name":"api","kind":"workload","description":"old","spec":{"image":"api:1"}}`,
+	}}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+	k8s := fake.NewClientBuilder().WithObjects(cr, ns).WithStatusSubresource(cr).Build()
+	key := client.ObjectKeyFromObject(cr)
+	if _, err := controllers.NewReconciler(k8s, opts, gvk).Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("reconcile failed: %v", err)
+	}
+
+	// Scenario: The merged CR is pushed, and written back, so that the CR includes the changes made in Control Plane.
+	if pushed["description"] != "console" || pushed["spec"].(map[string]any)["image"] != "api:2" {
+		t.Errorf("pushed = %v, want the console description and the new image", pushed)
+	}
+	stored := &unstructured.Unstructured{}
+	stored.SetGroupVersionKind(gvk)
+	if err := k8s.Get(ctx, key, stored); err != nil {
+		t.Fatal(err)
+	}
+	if stored.Object["description"] != "console" {
+		t.Errorf("description = %v, want the merged console description", stored.Object["description"])
+	}
+
+	// Scenario: The version of the pushed resource is recorded, so the next change to the CR isn't held.
+	if version, _, _ := unstructured.NestedInt64(stored.Object, "status", "operator", "remoteVersion"); version != 6 {
+		t.Errorf("remoteVersion = %d, want 6", version)
+	}
+}
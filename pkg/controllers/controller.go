@@ -8,8 +8,6 @@ import (
 	"github.com/controlplane-com/k8s-operator/pkg/common"
 	"github.com/controlplane-com/k8s-operator/pkg/cpln"
 	"github.com/controlplane-com/k8s-operator/pkg/realtime"
-	"github.com/controlplane-com/k8s-operator/pkg/websocket"
-	"github.com/controlplane-com/types-go/pkg/deployment"
 	"github.com/evanphx/json-patch/v5"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -20,16 +18,22 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"maps"
 	"net/http"
 	"os"
 	"path"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	crcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 	"slices"
 	"strings"
-	"time"
 )
 
 var ignoredKinds = []string{
@@ -51,75 +55,116 @@ type controller struct {
 	gvk           schema.GroupVersionKind
 	cplnConnector cpln.Connector
 	k8sConnector  Connector
+	opts          Options
+	syncs         *realtime.Registry
+	feed          *changeFeed
+	shards        *shards
+	bulkPolled    bool
+	recorder      record.EventRecorder
 }
 
 var zeroResult = ctrl.Result{}
 
-var defaultResult = ctrl.Result{
-	RequeueAfter: time.Second * time.Duration(common.GetEnvInt("RECONCILE_INTERVAL_SECONDS", 30)),
-}
-
-var ignoredFields = []string{"version", "gvc", "lastModified"}
+// ignoredFields are left out when comparing a CR with Control Plane. The status is synced separately, and the server
+// fields never belong in the CR.
+var ignoredFields = append([]string{"gvc", "status"}, cpln.ServerFields...)
 
-func BuildControllers(mgr ctrl.Manager) error {
-	if !common.GetEnvBool("CONTROLLER_ENABLED", true) {
-		return nil
+func newController(mgr ctrl.Manager, opts Options, syncs *realtime.Registry, gvk schema.GroupVersionKind, cplnConnector cpln.Connector, k8sConnector Connector) *controller {
+	opts.Extensions[gvk.Kind].apply(cplnConnector)
+	if opts.Shadow {
+		cplnConnector = shadowConnector{Connector: cplnConnector}
 	}
-	url := common.GetEnvStr("CPLN_API_URL", "https://api.cpln.io")
-	if err := buildGenericControllers(mgr, url); err != nil {
-		return err
+	return &controller{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		HttpClient:    opts.HTTPClient,
+		gvk:           gvk,
+		cplnConnector: cplnConnector,
+		k8sConnector:  k8sConnector,
+		opts:          opts,
+		syncs:         syncs,
+		recorder:      mgr.GetEventRecorderFor("cpln-operator"),
 	}
-	return buildSpecializedControllers(mgr, url)
 }
 
-func buildGenericControllers(mgr ctrl.Manager, url string) error {
-	configuredKinds := common.GetEnvSlice[string]("MANAGE_KINDS", nil)
-	gvks, err := listGVKForCRDs()
+func buildGenericControllers(mgr ctrl.Manager, opts Options, syncs *realtime.Registry, feed *changeFeed, s *shards) error {
+	gvks, err := listGVKForCRDs(opts.CRDDirectory)
 	if err != nil {
 		return err
 	}
 	for _, gvk := range gvks {
-		if configuredKinds != nil && !slices.Contains(configuredKinds, gvk.Kind) {
+		if len(opts.Kinds) > 0 && !slices.Contains(opts.Kinds, gvk.Kind) {
 			continue
 		}
 		obj := &unstructured.Unstructured{}
 		obj.SetGroupVersionKind(gvk)
-		r := &controller{
-			cplnConnector: cpln.NewGenericConnector(mgr.GetClient(), url),
-			Client:        mgr.GetClient(),
-			Scheme:        mgr.GetScheme(),
-			gvk:           gvk,
-			k8sConnector:  NewGenericConnector(gvk, mgr.GetClient()),
+		r := newController(mgr, opts, syncs, gvk,
+			cpln.NewGenericConnector(opts.Credentials, opts.HTTPClient, opts.APIURL),
+			NewGenericConnector(gvk, mgr.GetClient()))
+		r.feed = feed
+		r.shards = s
+		orgs := &orgIndex{}
+		b := ctrl.NewControllerManagedBy(mgr).Named(fmt.Sprintf("%s_controller", gvk.Kind)).
+			WithOptions(crcontroller.Options{
+				MaxConcurrentReconciles: opts.maxConcurrentReconciles(gvk.Kind),
+				NewQueue:                newFairWorkQueue(orgs),
+				NeedLeaderElection:      ptr.To(!opts.Sharding),
+			}).
+			For(obj, builder.WithPredicates(managedPredicate(opts), orgs.predicate()))
+		//CRs are also queued by the poller and the change feed, when their Control Plane resource changes
+		events := make(chan event.GenericEvent, changeQueueSize)
+		watchesEvents := false
+		//Kinds with their own URLs can't be listed generically, so they keep pulling each resource on its own
+		if !opts.DisableBulkPolling && r.extension().UrlProvider == nil {
+			if err = mgr.Add(newPoller(mgr.GetCache(), r, events)); err != nil {
+				return err
+			}
+			r.bulkPolled = true
+			watchesEvents = true
 		}
-		err = ctrl.NewControllerManagedBy(mgr).Named(fmt.Sprintf("%s_controller", gvk.Kind)).For(obj).Complete(r)
-		if err != nil {
+		if feed != nil {
+			if err = mgr.GetFieldIndexer().IndexField(context.Background(), obj, remoteIndex, indexRemote); err != nil {
+				return err
+			}
+			feed.watch(gvk, events)
+			watchesEvents = true
+		}
+		if s != nil {
+			s.onMembershipChange(func() {
+				resync(mgr.GetCache(), gvk, events)
+			})
+			watchesEvents = true
+		}
+		if watchesEvents {
+			b = b.WatchesRawSource(source.Channel(events, &handler.EnqueueRequestForObject{}))
+		}
+		if err = b.Complete(r); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func buildSpecializedControllers(mgr ctrl.Manager, url string) error {
-	//TODO: add more specialized controllers here as needed
-	return buildSecretController(mgr, url)
+func buildSpecializedControllers(mgr ctrl.Manager, opts Options, syncs *realtime.Registry, s *shards) error {
+	//Kind-specific behavior belongs in a KindExtension. Only resources that aren't cpln.io CRs need their own controller
+	return buildSecretController(mgr, opts, syncs, s)
 }
 
-func buildSecretController(mgr ctrl.Manager, url string) error {
+func buildSecretController(mgr ctrl.Manager, opts Options, syncs *realtime.Registry, s *shards) error {
 	secret := &corev1.Secret{}
 	secret.SetGroupVersionKind(common.NativeSecretGVK)
-	r := &controller{
-		cplnConnector: cpln.NewSecretConnector(mgr.GetClient(), url),
-		Client:        mgr.GetClient(),
-		Scheme:        mgr.GetScheme(),
-		gvk:           common.NativeSecretGVK,
-		k8sConnector:  NewSecretConnector(mgr.GetClient()),
-	}
-	return ctrl.NewControllerManagedBy(mgr).Named("secret_controller").For(secret, builder.WithPredicates(syncPredicate())).Complete(r)
+	r := newController(mgr, opts, syncs, common.NativeSecretGVK,
+		cpln.NewSecretConnector(opts.Credentials, opts.HTTPClient, opts.APIURL),
+		NewSecretConnector(mgr.GetClient()))
+	r.shards = s
+	return ctrl.NewControllerManagedBy(mgr).Named("secret_controller").
+		WithOptions(crcontroller.Options{NeedLeaderElection: ptr.To(!opts.Sharding)}).
+		For(secret, builder.WithPredicates(syncPredicate(opts.namespace()), managedPredicate(opts))).Complete(r)
 }
 
-func listGVKForCRDs() ([]schema.GroupVersionKind, error) {
+func listGVKForCRDs(dir string) ([]schema.GroupVersionKind, error) {
 	var gvks []schema.GroupVersionKind
-	entries, err := os.ReadDir("chart/templates/crd")
+	entries, err := os.ReadDir(dir)
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +174,7 @@ func listGVKForCRDs() ([]schema.GroupVersionKind, error) {
 		if slices.Contains(ignoredKinds, kind) {
 			continue
 		}
-		b, err := os.ReadFile(path.Join("chart/templates/crd", e.Name()))
+		b, err := os.ReadFile(path.Join(dir, e.Name()))
 		if err != nil {
 			return nil, err
 		}
@@ -146,13 +191,49 @@ func listGVKForCRDs() ([]schema.GroupVersionKind, error) {
 	return gvks, nil
 }
 
+func (r *controller) defaultResult() ctrl.Result {
+	return ctrl.Result{RequeueAfter: r.opts.ReconcileInterval}
+}
+
+// pollResult is returned after a successful sync. Resources of bulk polled kinds are only requeued by their poller.
+func (r *controller) pollResult() ctrl.Result {
+	if r.bulkPolled {
+		return zeroResult
+	}
+	return r.defaultResult()
+}
+
+// releaseFeed stops following the changes of the org of a CR that's gone or reconciled elsewhere, once no other CR of
+// this replica needs them
+func (r *controller) releaseFeed(key types.NamespacedName) error {
+	if r.feed == nil {
+		return nil
+	}
+	return r.feed.release(r.gvk.Kind, key)
+}
+
 func (r *controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	l := log.FromContext(ctx)
 
 	cr, err := r.k8sConnector.Read(ctx, req.NamespacedName)
-	if err != nil || cr == nil {
+	if err != nil {
 		return zeroResult, err
 	}
+	if cr == nil {
+		return zeroResult, r.releaseFeed(req.NamespacedName)
+	}
+	//Another replica reconciles the CR. It may have been handed over by this one, so its realtime syncs are released.
+	//Releasing them needs no token, so none is fetched for a CR of another replica.
+	if !r.shards.owns(cr) {
+		l.V(1).Info("CR belongs to another shard, skipping")
+		org, _ := cr.Object["org"].(string)
+		gvc, _ := cr.Object["gvc"].(string)
+		if err := r.releaseFeed(req.NamespacedName); err != nil {
+			return zeroResult, err
+		}
+		return zeroResult, r.cleanupSync(cpln.NewContext(ctx, org, gvc, ""), cr)
+	}
+
 	cplnContext, err := r.cplnConnector.Context(ctx, cr)
 	if err != nil {
 		return zeroResult, err
@@ -164,6 +245,17 @@ func (r *controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return zeroResult, nil
 	}
 
+	if r.feed != nil {
+		if err := r.feed.subscribe(cplnContext, cr); err != nil {
+			l.Error(err, "Failed to subscribe to the Control Plane change feed, changes are picked up by polling")
+		}
+	}
+
+	//A requested reconcile is pushed right away, whatever the backoff of earlier failures. That happens once per request.
+	if reconcileRequested(cr) {
+		resetRetries(cr)
+	}
+
 	nextSync, timeUntilSync := timeUntilNextSync(cr)
 	if timeUntilSync > 0 {
 		l.Info(getSyncFailureMessage(nextSync))
@@ -172,12 +264,33 @@ func (r *controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}, nil
 	}
 
+	//A paused CR isn't deleted from Control Plane either. It keeps its finalizer until the pause is lifted.
+	if reason := r.pauseReason(cr); reason != "" {
+		l.Info("Sync is paused, skipping", "reason", reason)
+		if setCondition(cr, ConditionPaused, "True", reason, "Syncing with Control Plane is paused.") {
+			if err := r.k8sConnector.WriteStatus(ctx, cr); err != nil {
+				return zeroResult, err
+			}
+		}
+		return r.defaultResult(), nil
+	}
+	removeCondition(cr, ConditionPaused)
+
 	deletedTimestamp := md["deletionTimestamp"]
 	if deletedTimestamp != nil {
 		return r.handleResourceDeletion(cplnContext, cr, l)
 	}
 
-	if err = r.syncChildren(cplnContext, req, cr); err != nil {
+	if !r.opts.Shadow {
+		delete(operatorStatus(cr), "wouldHaveDone")
+	}
+
+	if isParked(cr) {
+		l.Info("The last sync failed with an error that retrying can't fix. Waiting for the resource to change")
+		return zeroResult, nil
+	}
+
+	if err = r.syncChildren(cplnContext, cr); err != nil {
 		return zeroResult, err
 	}
 
@@ -185,34 +298,58 @@ func (r *controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	st := operatorStatus(cr)
 	cplnLastSynced, _ := st["lastSyncedGeneration"].(int64)
 	var result ctrl.Result
-	if cplnLastSynced == g {
+	switch {
+	case cplnLastSynced == g && !actionsChanged(cr):
 		result, err = r.syncFromCplnToK8s(cplnContext, l, cr)
-	} else {
-		result, err = r.syncFromK8sToCpln(cplnContext, l, cr)
+	case r.planOnly(cr):
+		result, err = r.plan(cplnContext, l, cr)
+	default:
+		result, err = r.push(cplnContext, l, cr)
 	}
 	if err != nil {
-		syncFailed(cr, err.Error())
+		class := syncFailed(cr, err)
+		l.Error(err, "Sync failed", "reason", class)
 		if err := r.k8sConnector.WriteStatus(ctx, cr); err != nil {
 			l.Error(err, "Error updating status with sync failure")
+			return zeroResult, err
+		}
+		if common.IsPermanentError(class) {
+			return zeroResult, nil
 		}
+		nextSync, timeUntilSync := timeUntilNextSync(cr)
+		l.Info(getSyncFailureMessage(nextSync))
+		return ctrl.Result{RequeueAfter: timeUntilSync}, nil
 	}
-	return result, err
+	return result, nil
 }
 
 func (r *controller) handleResourceDeletion(ctx cpln.Context, cr *unstructured.Unstructured, l logr.Logger) (ctrl.Result, error) {
+	keep := resourcePolicy(cr) == common.RESOURCE_POLICY_KEEP
+	if !keep {
+		var held bool
+		var err error
+		if keep, held, err = r.deletionGuard(ctx, l, cr); err != nil {
+			return zeroResult, err
+		}
+		if held {
+			return r.defaultResult(), nil
+		}
+	}
 	if err := r.cleanupSync(ctx, cr); err != nil {
 		return zeroResult, err
 	}
-	if resourcePolicy(cr) != common.RESOURCE_POLICY_KEEP {
+	if !keep && r.opts.Shadow {
+		r.shadowed(ctx, ShadowDelete, "delete the resource from Control Plane")
+	} else if !keep {
 		err := r.cplnConnector.Delete(ctx, cr)
 		if err != nil {
-			syncFailed(cr, err.Error())
+			syncFailed(cr, err)
 			if err := r.k8sConnector.WriteStatus(ctx, cr); err != nil {
 				l.Error(err, "Error updating status with sync failure")
 			}
 			l.Error(err, "Failed to delete from Cpln")
 			if errors.Is(err, common.DependentResourceErr) {
-				return defaultResult, nil
+				return r.defaultResult(), nil
 			}
 			return zeroResult, err
 		}
@@ -224,138 +361,63 @@ func (r *controller) handleResourceDeletion(ctx cpln.Context, cr *unstructured.U
 }
 
 func (r *controller) cleanupSync(ctx cpln.Context, cr *unstructured.Unstructured) error {
-	switch r.gvk.Kind {
-	case common.KIND_WORKLOAD:
-		return realtime.DeregisterSync(fmt.Sprintf("%s.%s.%s", ctx.Org(), ctx.Gvc(), cr.GetName()))
-	default:
-		return nil
-	}
-}
-
-func (r *controller) syncChildren(ctx cpln.Context, req ctrl.Request, cr *unstructured.Unstructured) error {
-	syncCtx := newSyncContext(ctx, r.Client)
-	syncCtx.namespace = req.Namespace
-	syncCtx.parent = cr
-
-	switch r.gvk.Kind {
-	case common.KIND_WORKLOAD:
-		if err := r.websocketDeploymentSync(ctx, cr); err != nil {
-			return err
-		}
-	case common.KIND_VOLUME_SET:
-		if err := syncVolumeSetStatusLocations(syncCtx, cr); err != nil {
-			return err
-		}
-		break
+	if cleanup := r.extension().Cleanup; cleanup != nil {
+		return cleanup(r.extensionContext(ctx, cr), cr)
 	}
 	return nil
 }
 
-func (r *controller) websocketDeploymentSync(ctx cpln.Context, cr *unstructured.Unstructured) error {
-	org := ctx.Org()
-	gvc := ctx.Gvc()
-	token := ctx.Token()
-	fullName := fmt.Sprintf("%s.%s.%s", org, gvc, cr.GetName())
-	s := realtime.GetSync(fullName)
-	if s != nil {
+func (r *controller) syncChildren(ctx cpln.Context, cr *unstructured.Unstructured) error {
+	syncChildren := r.extension().SyncChildren
+	if syncChildren == nil {
 		return nil
 	}
-	url := common.GetEnvStr("CPLN_WORKLOAD_STATUS_URL", "wss://workload-status.cpln.io/register")
-	parent := cr.DeepCopy()
-	background := context.Background()
-	l := log.FromContext(background)
-
-	messageHandler := func(message []byte) error {
-		return r.handleWorkloadStatusMessage(background, ctx, parent, message)
-	}
-	connectHandler := func(w websocket.Client) error {
-		return registerInterest(w, token, Interest{
-			Org:      org,
-			Gvc:      gvc,
-			Workload: cr.GetName(),
-		})
-	}
-
-	w, err := websocket.NewClient(background, l, url, token, time.Second*5, messageHandler, connectHandler)
-	if err != nil {
-		return err
+	ext := r.extensionContext(ctx, cr)
+	err := syncChildren(ext, cr)
+	if shadow, ok := ext.Client.(*shadowClient); ok {
+		for _, entry := range shadow.drain() {
+			wouldHaveDone(cr, entry)
+		}
 	}
-	realtime.RegisterSync(fullName, w)
-	return nil
+	return err
 }
 
-func (r *controller) syncWorkloadDeployments(ctx *syncContext, deployments []deployment.Deployment) error {
-	var deploymentCRs []*unstructured.Unstructured
-	for _, d := range deployments {
-		cr, err := unstructuredCR(common.DeploymentGVK, ctx.namespace, d.Name, d, ctx.parent)
-		if err != nil {
-			return err
-		}
-		setDeploymentHealth(cr, d.Status.Versions)
-		synced(cr, true, nil)
-		delete(cr.Object["status"].(map[string]any), "internal")
-		deploymentCRs = append(deploymentCRs, cr)
-	}
-	deletedDeployments, err := syncCRs(ctx.copy(), deploymentCRs, common.DeploymentGVK)
-	if err != nil {
-		return err
-	}
+func (r *controller) extension() KindExtension {
+	return r.opts.Extensions[r.gvk.Kind]
+}
 
-	currentParent := ctx.parent.DeepCopy()
-	err = r.Get(ctx, types.NamespacedName{
-		Name:      ctx.parent.GetName(),
-		Namespace: ctx.parent.GetNamespace(),
-	}, currentParent)
-	if err != nil {
-		return err
+// extensionContext returns the context of the extensions called for the CR. In shadow mode, their writes to anything
+// but the status of the CR are skipped.
+func (r *controller) extensionContext(ctx cpln.Context, cr *unstructured.Unstructured) *ExtensionContext {
+	var c client.Client = r.Client
+	if r.opts.Shadow {
+		c = r.shadowClient(cr)
 	}
-	ctx.parent = currentParent
-
-	if err = r.setWorkloadHealth(ctx, ctx.parent, deploymentCRs); err != nil {
-		return err
+	return &ExtensionContext{
+		Context:   ctx,
+		Client:    c,
+		Connector: r.cplnConnector,
+		Options:   r.opts,
+		Syncs:     r.syncs,
 	}
-
-	for i, d := range deployments {
-		if slices.Contains(deletedDeployments, d.Name) {
-			continue
-		}
-		ctx.parent = deploymentCRs[i]
-		if err = syncDeploymentVersions(ctx.copy(), d.Status.Versions); err != nil {
-			return err
-		}
-		if err = syncJobExecutions(ctx.copy(), d.Status.JobExecutions); err != nil {
-			return err
-		}
-	}
-
-	return nil
 }
 
-func registerInterest(w websocket.Client, token string, interest Interest) error {
-	b, err := json.Marshal(RegisterInterestRequest{
-		Token:     token,
-		Interests: []Interest{interest},
-	})
-	if err != nil {
-		return err
+// withActions returns the CR to push, with the overrides requested by its action annotations applied
+func (r *controller) withActions(cr *unstructured.Unstructured) *unstructured.Unstructured {
+	apply := r.extension().ApplyActions
+	if apply == nil {
+		return cr
 	}
-	return w.Send(b)
+	cr = cr.DeepCopy()
+	apply(cr)
+	return cr
 }
 
-func (r *controller) verifyParent(ctx context.Context, parent *unstructured.Unstructured) error {
-	l := log.FromContext(ctx)
-	var currentParent unstructured.Unstructured
-	currentParent.SetGroupVersionKind(parent.GroupVersionKind())
-	//For safety, verify that the parent the websocket client was configured with still matches the one in the cluster
-	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: parent.GetNamespace(), Name: parent.GetName()}, &currentParent); err != nil {
-		//Do nothing. Can't verify parent identity
-		l.Error(err, "Failed to get parent")
-		return err
+// evaluateHealth sets the health of the CR from its Control Plane status, for kinds with a HealthEvaluator
+func (r *controller) evaluateHealth(cr *unstructured.Unstructured) {
+	if evaluate := r.extension().EvaluateHealth; evaluate != nil {
+		setHealth(cr, evaluate(cr))
 	}
-	if parent.GetUID() != currentParent.GetUID() {
-		*parent = currentParent
-	}
-	return nil
 }
 
 func (r *controller) syncFromCplnToK8s(ctx cpln.Context, log logr.Logger, cr *unstructured.Unstructured) (ctrl.Result, error) {
@@ -367,55 +429,48 @@ func (r *controller) syncFromCplnToK8s(ctx cpln.Context, log logr.Logger, cr *un
 		return zeroResult, err
 	}
 	if errors.Is(err, common.NotFoundError) {
-		log.Info("Resource not found on Control Plane, deleting from Kubernetes")
-		if err := r.k8sConnector.Cleanup(ctx, cr); err != nil {
-			log.Error(err, "Error deleting from Kubernetes")
-			return zeroResult, err
-		}
-		return zeroResult, nil
+		return r.remoteDeleted(ctx, log, cr)
 	}
 
 	var cplnResourceMap map[string]any
 	err = json.Unmarshal(cplnResource, &cplnResourceMap)
 	if err != nil {
 		log.Info(fmt.Sprintf("Got non-JSON response from Control Plane: %s", cplnResource))
-		return defaultResult, nil
+		return r.defaultResult(), nil
+	}
+
+	//The dry run is only needed when the resource moved since it was last synced
+	if version, ok := remoteVersion(cr); ok && cplnResourceMap["version"] == float64(version) {
+		log.Info("Control Plane version unchanged since the last sync, skipping the dry run")
+		return r.pulledStatus(ctx, log, cr, cplnResourceMap)
 	}
 
-	cplnResourceAfterDryRun, err := r.cplnConnector.Put(ctx, cr, true)
+	cplnResourceAfterDryRun, err := r.cplnConnector.Put(ctx, r.withActions(r.preserveUnmanaged(cr, cplnResourceMap)), true)
 	if err != nil {
 		log.Error(err, "Error during cpln dry run")
 		return zeroResult, err
 	}
 
-	patch, err := jsonpatch.CreateMergePatch([]byte(cplnResourceAfterDryRun), cplnResource)
+	patchMap, err := diff([]byte(cplnResourceAfterDryRun), cplnResource)
 	if err != nil {
 		log.Error(err, "Error creating merge patch")
 		return zeroResult, err
 	}
-
-	patchMap := map[string]any{}
-	if err = json.Unmarshal(patch, &patchMap); err != nil {
-		log.Error(err, "Error unmarshalling patch")
-		return zeroResult, err
-	}
 	for _, field := range ignoredFields {
 		delete(patchMap, field)
 	}
-	patch, err = json.Marshal(patchMap)
-	if err != nil {
-		log.Error(err, "Error marshalling patch")
-		return zeroResult, err
+	for _, field := range r.unmanagedFields(cr) {
+		pointerDelete(patchMap, field)
 	}
 
 	//No changes
-	if len(patch) == 0 || string(patch) == "{}" {
-		synced(cr, false, cplnResourceMap["status"])
-		if err := r.k8sConnector.WriteStatus(ctx, cr); err != nil {
-			log.Error(err, "Failed to update resource status after pulling from Control Plane")
-			return zeroResult, err
-		}
-		return defaultResult, nil
+	if len(patchMap) == 0 {
+		return r.pulledStatus(ctx, log, cr, cplnResourceMap)
+	}
+	patch, err := json.Marshal(patchMap)
+	if err != nil {
+		log.Error(err, "Error marshalling patch")
+		return zeroResult, err
 	}
 
 	cplnObj, err := r.cplnConnector.CplnFormat(cr)
@@ -446,13 +501,25 @@ func (r *controller) syncFromCplnToK8s(ctx cpln.Context, log logr.Logger, cr *un
 		return zeroResult, err
 	}
 
-	if err := r.k8sConnector.Write(ctx, cr); err != nil {
+	var skipped string
+	if r.opts.Shadow {
+		fields := slices.Sorted(maps.Keys(patchMap))
+		skipped = r.shadowed(ctx, ShadowPullWrite, fmt.Sprintf("update %s of the CR with the changes made in Control Plane", strings.Join(fields, ", ")))
+	} else if err := r.k8sConnector.Write(ctx, cr); err != nil {
 		log.Error(err, "Failed to patch k8s resource(s) with the updates from Control Plane")
 		return zeroResult, err
 	}
 
 	for {
 		synced(cr, false, cplnResourceMap["status"])
+		if skipped != "" {
+			//The CR still differs from Control Plane, so the version isn't recorded, and the changes are pulled once
+			//shadow mode is off
+			wouldHaveDone(cr, skipped)
+		} else {
+			r.recordRemote(cr, cplnResourceMap)
+		}
+		r.evaluateHealth(cr)
 		if err := r.k8sConnector.WriteStatus(ctx, cr); err == nil {
 			break
 		}
@@ -472,18 +539,41 @@ func (r *controller) syncFromCplnToK8s(ctx cpln.Context, log logr.Logger, cr *un
 		return zeroResult, err
 	}
 
-	return defaultResult, nil
+	return r.pollResult(), nil
+}
+
+// pulledStatus updates the status of a CR whose spec matches Control Plane
+func (r *controller) pulledStatus(ctx cpln.Context, log logr.Logger, cr *unstructured.Unstructured, cplnResourceMap map[string]any) (ctrl.Result, error) {
+	synced(cr, false, cplnResourceMap["status"])
+	r.recordRemote(cr, cplnResourceMap)
+	r.evaluateHealth(cr)
+	if err := r.k8sConnector.WriteStatus(ctx, cr); err != nil {
+		log.Error(err, "Failed to update resource status after pulling from Control Plane")
+		return zeroResult, err
+	}
+	return r.pollResult(), nil
 }
 
 func (r *controller) syncFromK8sToCpln(ctx cpln.Context, log logr.Logger, cr *unstructured.Unstructured) (ctrl.Result, error) {
 	log.Info("lastSyncedGeneration != generation, pushing to Control Plane")
-	cplnResourceAfterUpdate, err := r.cplnConnector.Put(ctx, cr, false)
+	live, err := r.liveResource(ctx, log, cr)
 	if err != nil {
-		log.Error(err, "Failed to PUT resource to Control Plane")
-		syncFailed(cr, err.Error())
+		return zeroResult, err
+	}
+	toPush, err := r.resolveConflicts(ctx, log, cr, live)
+	if err != nil {
+		return zeroResult, err
+	}
+	if toPush == nil {
 		if err := r.k8sConnector.WriteStatus(ctx, cr); err != nil {
-			log.Error(err, "Error updating status with sync failure")
+			log.Error(err, "Failed to update resource status with the conflict")
+			return zeroResult, err
 		}
+		return r.defaultResult(), nil
+	}
+	cplnResourceAfterUpdate, err := r.cplnConnector.Put(ctx, r.withActions(r.preserveUnmanaged(toPush, live)), false)
+	if err != nil {
+		log.Error(err, "Failed to PUT resource to Control Plane")
 		return zeroResult, err
 	}
 
@@ -491,10 +581,6 @@ func (r *controller) syncFromK8sToCpln(ctx cpln.Context, log logr.Logger, cr *un
 		b, err := r.cplnConnector.Get(ctx, cr)
 		if err != nil {
 			log.Error(err, "Failed to GET resource from Control Plane")
-			syncFailed(cr, err.Error())
-			if err := r.k8sConnector.WriteStatus(ctx, cr); err != nil {
-				log.Error(err, "Error updating status with sync failure")
-			}
 			return zeroResult, err
 		}
 		cplnResourceAfterUpdate = string(b)
@@ -506,78 +592,30 @@ func (r *controller) syncFromK8sToCpln(ctx cpln.Context, log logr.Logger, cr *un
 		return zeroResult, err
 	}
 
-	synced(cr, false, responseMap["status"])
-	if err := r.k8sConnector.WriteStatus(ctx, cr); err != nil {
-		log.Error(err, "Failed to update resource status after pulling from Control Plane")
-		return zeroResult, err
-	}
-	return defaultResult, nil
-}
-
-func setDeploymentHealth(deploy *unstructured.Unstructured, versions []deployment.DeploymentVersion) {
-	//Unhealthy?
-	if !anyVersionReady(versions) {
-		unhealthy(deploy)
-		var m []string
-		m = append(m, "All versions of this deployment are unhealthy.")
-		m = append(m, collectDeploymentMessages([]*unstructured.Unstructured{deploy})...)
-		addErrorMessages(deploy, m...)
-		return
-	}
-
-	//Progressing?
-	if anyVersionUnready(versions) {
-		progressing(deploy)
-		return
-	}
-
-	//Ready!
-	ready(deploy)
-}
-
-func (r *controller) setWorkloadHealth(ctx context.Context, workload *unstructured.Unstructured, deployments []*unstructured.Unstructured) error {
-	setStatus := func() {
-		for _, d := range deployments {
-			if isUnhealthy(d) {
-				unhealthy(workload)
-				var m []string
-				m = append(m, "At least one deployment of this workload is unhealthy.")
-				m = append(m, collectDeploymentMessages(deployments)...)
-				addErrorMessages(workload, m...)
-				return
-			}
-		}
-		for _, d := range deployments {
-			if isProgressing(d) {
-				progressing(workload)
-				return
-			}
-		}
-		for _, d := range deployments {
-			if !isSuspended(d) {
-				ready(workload)
-				return
-			}
+	if run := r.extension().RunActions; run != nil && actionsChanged(cr) {
+		if err := run(r.extensionContext(ctx, cr), cr); err != nil {
+			log.Error(err, "Failed to run the requested actions")
+			return zeroResult, err
 		}
-		suspended(workload)
 	}
+	recordActions(cr)
 
-	setStatus()
-	return r.Status().Update(ctx, workload)
-}
-
-func (r *controller) handleWorkloadStatusMessage(background context.Context, ctx cpln.Context, parent *unstructured.Unstructured, _ []byte) error {
-	l := log.FromContext(background)
-	syncCtx := newSyncContext(background, r.Client)
-	if err := r.verifyParent(background, parent); err != nil {
-		return nil
+	//After a merge, the CR lacks the changes made in Control Plane, so the merged CR is written, as a pull would
+	if toPush != cr {
+		status := cr.Object["status"]
+		if err := r.k8sConnector.Write(ctx, toPush); err != nil {
+			log.Error(err, "Failed to write the merged changes to the CR")
+			return zeroResult, err
+		}
+		toPush.Object["status"] = status
+		cr = toPush
 	}
-	syncCtx.parent = parent
-	syncCtx.namespace = parent.GetNamespace()
-	deployments, err := cpln.GetWorkloadDeploymentsFromCpln(ctx, r.cplnConnector, parent)
-	if err != nil {
-		l.Error(err, "Failed to get deployments from Cpln")
-		return nil
+	synced(cr, false, responseMap["status"])
+	r.recordRemote(cr, responseMap)
+	r.evaluateHealth(cr)
+	if err := r.k8sConnector.WriteStatus(ctx, cr); err != nil {
+		log.Error(err, "Failed to update resource status after pulling from Control Plane")
+		return zeroResult, err
 	}
-	return r.syncWorkloadDeployments(syncCtx, deployments)
+	return r.pollResult(), nil
 }
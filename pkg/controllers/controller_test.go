@@ -0,0 +1,175 @@
+package controllers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/controllers"
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestDeletingPausedCR(t *testing.T) {
+	var deletes atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			deletes.Add(1)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	ctx := context.Background()
+	gvk := schema.GroupVersionKind{Group: common.API_GROUP, Version: common.API_REVISION, Kind: common.KIND_WORKLOAD}
+	opts := controllers.Options{
+		APIURL: server.URL,
+		Credentials: cpln.CredentialProviderFunc(func(context.Context, string) (string, error) {
+			return "token", nil
+		}),
+	}
+	newCR := func(annotations map[string]string) *unstructured.Unstructured {
+		cr := &unstructured.Unstructured{Object: map[string]any{"org": "acme", "gvc": "main"}}
+		cr.SetGroupVersionKind(gvk)
+		cr.SetName("api")
+		cr.SetNamespace("default")
+		cr.SetFinalizers([]string{common.FINALIZER})
+		cr.SetAnnotations(annotations)
+		return cr
+	}
+	key := client.ObjectKey{Namespace: "default", Name: "api"}
+	reconcileDeleted := func(c client.Client, opts controllers.Options) {
+		t.Helper()
+		if _, err := controllers.NewReconciler(c, opts, gvk).Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("reconcile failed: %v", err)
+		}
+	}
+
+	for _, c := range []struct {
+		name        string
+		annotations map[string]string
+		opts        controllers.Options
+	}{
+		{"paused by annotation", map[string]string{common.PAUSED_ANNOTATION: "true"}, opts},
+		{"maintenance mode", nil, controllers.Options{APIURL: opts.APIURL, Credentials: opts.Credentials, Maintenance: true}},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			deletes.Store(0)
+			cr := newCR(c.annotations)
+			k8s := fake.NewClientBuilder().WithObjects(cr).WithStatusSubresource(cr).Build()
+			if err := k8s.Delete(ctx, cr); err != nil {
+				t.Fatal(err)
+			}
+
+			// Scenario: While paused, the resource isn't deleted from Control Plane and the CR keeps its finalizer.
+			reconcileDeleted(k8s, c.opts)
+			if n := deletes.Load(); n != 0 {
+				t.Errorf("deletes while paused = %d, want none", n)
+			}
+			stored := newCR(nil)
+			if err := k8s.Get(ctx, key, stored); err != nil {
+				t.Fatalf("CR gone while paused: %v", err)
+			}
+			conditions, _, _ := unstructured.NestedSlice(stored.Object, "status", "conditions")
+			if len(conditions) != 1 || conditions[0].(map[string]any)["type"] != controllers.ConditionPaused {
+				t.Errorf("conditions = %v, want Paused", conditions)
+			}
+
+			// Scenario: Once the pause is lifted, the deletion goes through.
+			stored.SetAnnotations(nil)
+			if err := k8s.Update(ctx, stored); err != nil {
+				t.Fatal(err)
+			}
+			reconcileDeleted(k8s, opts)
+			if n := deletes.Load(); n != 1 {
+				t.Errorf("deletes after the pause = %d, want 1", n)
+			}
+			if err := k8s.Get(ctx, key, newCR(nil)); !apierrors.IsNotFound(err) {
+				t.Errorf("get after the pause = %v, want not found", err)
+			}
+		})
+	}
+}
+
+func TestReconcileRequestKeepsFailing(t *testing.T) {
+	var pushes atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("dryRun") != "true" {
+			pushes.Add(1)
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	ctx := context.Background()
+	gvk := schema.GroupVersionKind{Group: common.API_GROUP, Version: common.API_REVISION, Kind: common.KIND_WORKLOAD}
+	opts := controllers.Options{
+		APIURL: server.URL,
+		Credentials: cpln.CredentialProviderFunc(func(context.Context, string) (string, error) {
+			return "token", nil
+		}),
+	}
+	cr := &unstructured.Unstructured{Object: map[string]any{"org": "acme", "gvc": "main", "spec": map[string]any{"image": "api:1"}}}
+	cr.SetGroupVersionKind(gvk)
+	cr.SetName("api")
+	cr.SetNamespace("default")
+	cr.SetGeneration(1)
+	cr.Object["status"] = map[string]any{"operator": map[string]any{"lastSyncedGeneration": int64(1)}}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+	k8s := fake.NewClientBuilder().WithObjects(cr, ns).WithStatusSubresource(cr).Build()
+	key := client.ObjectKeyFromObject(cr)
+	reconcile := func(requestedAt string) *unstructured.Unstructured {
+		t.Helper()
+		stored := &unstructured.Unstructured{}
+		stored.SetGroupVersionKind(gvk)
+		if err := k8s.Get(ctx, key, stored); err != nil {
+			t.Fatal(err)
+		}
+		if stored.GetAnnotations()[common.RECONCILE_REQUESTED_AT_ANNOTATION] != requestedAt {
+			stored.SetAnnotations(map[string]string{common.RECONCILE_REQUESTED_AT_ANNOTATION: requestedAt})
+			if err := k8s.Update(ctx, stored); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, err := controllers.NewReconciler(k8s, opts, gvk).Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("reconcile failed: %v", err)
+		}
+		if err := k8s.Get(ctx, key, stored); err != nil {
+			t.Fatal(err)
+		}
+		return stored
+	}
+
+	nextRetry := func(cr *unstructured.Unstructured) string {
+		next, _, _ := unstructured.NestedString(cr.Object, "status", "operator", "nextRetryTime")
+		return next
+	}
+
+	// Scenario: A requested reconcile whose push keeps failing backs off, instead of pushing on every reconcile.
+	stored := reconcile("2026-01-01T00:00:00Z")
+	backoff := nextRetry(stored)
+	for i := 0; i < 4; i++ {
+		stored = reconcile("2026-01-01T00:00:00Z")
+	}
+	if n := pushes.Load(); n != 1 || backoff == "" || nextRetry(stored) != backoff {
+		t.Errorf("pushes = %d, next retry %q then %q, want a single push backing off", n, backoff, nextRetry(stored))
+	}
+
+	// Scenario: A new request skips the backoff once more.
+	reconcile("2026-01-01T00:05:00Z")
+	if n := pushes.Load(); n != 2 {
+		t.Errorf("pushes after a new request = %d, want 2", n)
+	}
+}
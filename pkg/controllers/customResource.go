@@ -44,6 +44,10 @@ func unstructuredCR(gvk schema.GroupVersionKind, namespace, name string, body an
 		},
 	})
 	addLabel(obj, common.UID_LABEL, string(parent.GetUID()))
+	//Children belong to the operator class of their parent, which is the only one watching them
+	if class, ok := parent.GetLabels()[common.OPERATOR_CLASS_LABEL]; ok {
+		addLabel(obj, common.OPERATOR_CLASS_LABEL, class)
+	}
 	return obj, nil
 }
 
@@ -0,0 +1,37 @@
+package controllers_test
+
+import (
+	"testing"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/controllers"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestUnstructuredCRLabels(t *testing.T) {
+	parent := &unstructured.Unstructured{Object: map[string]any{"org": "acme", "gvc": "main"}}
+	parent.SetAPIVersion(common.API_VERSION)
+	parent.SetKind(common.KIND_WORKLOAD)
+	parent.SetName("api")
+	parent.SetUID(types.UID("uid-1"))
+
+	// Scenario: A child of the default class is left unlabelled, like its parent.
+	child, err := controllers.UnstructuredCR(common.DeploymentGVK, "default", "aws-eu-central-1", map[string]any{}, parent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := child.GetLabels()[common.OPERATOR_CLASS_LABEL]; ok {
+		t.Errorf("labels = %v, want no operator class", child.GetLabels())
+	}
+
+	// Scenario: A child inherits the operator class of its parent, next to the UID of the parent.
+	parent.SetLabels(map[string]string{common.OPERATOR_CLASS_LABEL: "canary"})
+	child, err = controllers.UnstructuredCR(common.DeploymentGVK, "default", "aws-eu-central-1", map[string]any{}, parent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if labels := child.GetLabels(); labels[common.OPERATOR_CLASS_LABEL] != "canary" || labels[common.UID_LABEL] != "uid-1" {
+		t.Errorf("labels = %v, want the canary class and the parent UID", labels)
+	}
+}
@@ -0,0 +1,46 @@
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// ConditionDeletionBlocked is set to True on a deleted CR whose resource is only deleted from Control Plane once the
+	// deletion is confirmed
+	ConditionDeletionBlocked = "DeletionBlocked"
+
+	ReasonConfirmationRequired = "ConfirmationRequired"
+	ReasonDeletionSkipped      = "DeletionSkipped"
+)
+
+// deletionGuard applies the deletion policy of the kind to a deleted CR. It returns whether the resource stays in
+// Control Plane, and whether the deletion of the CR is held until it's confirmed with the cpln.io/confirm-delete
+// annotation. The cpln.io/resource-policy annotation is checked before, and wins over the policy.
+func (r *controller) deletionGuard(ctx cpln.Context, log logr.Logger, cr *unstructured.Unstructured) (keep bool, held bool, err error) {
+	switch r.opts.DeletionPolicy(r.gvk.Kind) {
+	case common.DELETION_POLICY_NEVER:
+		log.Info("The deletion policy of the kind never deletes it from Control Plane, removing only the CR")
+		r.event(cr, corev1.EventTypeNormal, ReasonDeletionSkipped,
+			fmt.Sprintf("A %s is never deleted from Control Plane, removing only the CR", r.gvk.Kind))
+		return true, false, nil
+	case common.DELETION_POLICY_CONFIRM:
+		if cr.GetAnnotations()[common.CONFIRM_DELETE_ANNOTATION] == cr.GetName() {
+			return false, false, nil
+		}
+		before := cr.DeepCopy()
+		message := fmt.Sprintf("Set the %s annotation to %s to delete the %s from Control Plane, or the %s annotation to %s to remove only the CR.",
+			common.CONFIRM_DELETE_ANNOTATION, cr.GetName(), r.gvk.Kind, common.RESOURCE_POLICY_ANNOTATION, common.RESOURCE_POLICY_KEEP)
+		if setCondition(cr, ConditionDeletionBlocked, "True", ReasonConfirmationRequired, message) {
+			log.Info("Deletion is waiting for confirmation")
+			r.event(cr, corev1.EventTypeWarning, ReasonConfirmationRequired, message)
+		}
+		return false, true, r.writeChangedStatus(ctx, log, before, cr)
+	}
+	return false, false, nil
+}
@@ -0,0 +1,74 @@
+package controllers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/controllers"
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestDeletionGuard(t *testing.T) {
+	ctx := cpln.NewContext(context.Background(), "acme", "main", "token")
+	newCR := func(kind string, annotations map[string]string) *unstructured.Unstructured {
+		cr := &unstructured.Unstructured{Object: map[string]any{"org": "acme"}}
+		cr.SetGroupVersionKind(schema.GroupVersionKind{Group: common.API_GROUP, Version: common.API_REVISION, Kind: kind})
+		cr.SetName("main")
+		cr.SetNamespace("default")
+		cr.SetAnnotations(annotations)
+		return cr
+	}
+	guard := func(opts controllers.Options, cr *unstructured.Unstructured) (keep, held bool, c client.Client, recorder *record.FakeRecorder) {
+		t.Helper()
+		c = fake.NewClientBuilder().WithObjects(cr).WithStatusSubresource(cr).Build()
+		recorder = record.NewFakeRecorder(10)
+		keep, held, err := controllers.DeletionGuard(ctx, c, recorder, opts, cr)
+		if err != nil {
+			t.Fatalf("deletionGuard failed: %v", err)
+		}
+		return keep, held, c, recorder
+	}
+
+	// Scenario: An org is never deleted from Control Plane, but its CR is.
+	if keep, held, _, recorder := guard(controllers.Options{}, newCR(common.KIND_ORG, nil)); !keep || held || len(recorder.Events) != 1 {
+		t.Errorf("org: keep = %v, held = %v, events = %d, want kept, not held, one event", keep, held, len(recorder.Events))
+	}
+
+	// Scenario: An unconfirmed gvc is held with a DeletionBlocked condition.
+	cr := newCR(common.KIND_GVC, map[string]string{common.CONFIRM_DELETE_ANNOTATION: "other"})
+	keep, held, c, recorder := guard(controllers.Options{}, cr)
+	if keep || !held || len(recorder.Events) != 1 {
+		t.Errorf("unconfirmed gvc: keep = %v, held = %v, events = %d, want held, one event", keep, held, len(recorder.Events))
+	}
+	stored := newCR(common.KIND_GVC, nil)
+	if err := c.Get(ctx, client.ObjectKeyFromObject(cr), stored); err != nil {
+		t.Fatal(err)
+	}
+	conditions, _, _ := unstructured.NestedSlice(stored.Object, "status", "conditions")
+	if len(conditions) != 1 || conditions[0].(map[string]any)["type"] != controllers.ConditionDeletionBlocked {
+		t.Errorf("conditions = %v, want DeletionBlocked", conditions)
+	}
+
+	// Scenario: Confirming with the name of the CR lets the deletion through.
+	if keep, held, _, _ := guard(controllers.Options{}, newCR(common.KIND_GVC, map[string]string{common.CONFIRM_DELETE_ANNOTATION: "main"})); keep || held {
+		t.Errorf("confirmed gvc: keep = %v, held = %v, want deleted", keep, held)
+	}
+
+	// Scenario: The configured policies are merged over the defaults.
+	opts := controllers.Options{DeletionPolicyPerKind: map[string]string{common.KIND_GVC: common.DELETION_POLICY_DELETE, common.KIND_WORKLOAD: common.DELETION_POLICY_NEVER}}
+	if keep, held, _, _ := guard(opts, newCR(common.KIND_GVC, nil)); keep || held {
+		t.Errorf("gvc with delete policy: keep = %v, held = %v, want deleted", keep, held)
+	}
+	if keep, _, _, _ := guard(opts, newCR(common.KIND_WORKLOAD, nil)); !keep {
+		t.Error("workload with never policy isn't kept")
+	}
+	if keep, held, _, _ := guard(opts, newCR(common.KIND_MK8S, nil)); keep || !held {
+		t.Errorf("mk8s cluster: keep = %v, held = %v, want held by default", keep, held)
+	}
+}
@@ -3,13 +3,17 @@ package controllers
 import (
 	"context"
 	"fmt"
+	cplnv1 "github.com/controlplane-com/k8s-operator/pkg/apis/cpln/v1"
 	"github.com/controlplane-com/k8s-operator/pkg/common"
 	"github.com/controlplane-com/types-go/pkg/containerstatus"
 	"github.com/controlplane-com/types-go/pkg/cronjob"
 	"github.com/controlplane-com/types-go/pkg/deployment"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"slices"
+	"strings"
 	"time"
 )
 
@@ -182,24 +186,182 @@ func getMessage(m map[string]any) string {
 func collectDeploymentMessages(deployments []*unstructured.Unstructured) []string {
 	var messages []string
 	for _, d := range deployments {
-		status, ok := d.Object["status"].(map[string]any)
-		if ok {
-			messages = append(messages, getMessage(status))
-		}
-		for _, v := range status["versions"].([]any) {
-			v := v.(map[string]any)
-			m := getMessage(v)
-			if m != "" {
-				messages = append(messages, m)
+		typed := cplnv1.Deployment{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(d.Object, &typed); err != nil {
+			// Fall back to the top-level message rather than failing the health check on an unexpected shape
+			if status, ok := d.Object["status"].(map[string]any); ok {
+				messages = append(messages, getMessage(status))
 			}
-			for _, c := range v["containers"].(map[string]any) {
-				c := c.(map[string]any)
-				m := getMessage(c)
-				if m != "" {
-					messages = append(messages, m)
+			continue
+		}
+		messages = append(messages, typed.Status.Message)
+		for _, v := range typed.Status.Versions {
+			if v.Message != "" {
+				messages = append(messages, v.Message)
+			}
+			for _, c := range v.Containers {
+				if c.Message != "" {
+					messages = append(messages, c.Message)
 				}
 			}
 		}
 	}
 	return messages
 }
+
+// statusChildKinds lists the kinds of the child CRs of each kind of workload status CR
+var statusChildKinds = map[string][]schema.GroupVersionKind{
+	common.DeploymentGVK.Kind:         {common.DeploymentVersionGVK, common.JobExecutionStatusGVK},
+	common.DeploymentVersionGVK.Kind:  {common.ContainerStatusGVK},
+	common.JobExecutionStatusGVK.Kind: {common.ContainerStatusGVK},
+}
+
+// removeStatusCRs deletes the child CRs of the kind under the parent of ctx, children first. Switching to summary mode
+// uses it, so that no status CR is left waiting for garbage collection.
+func removeStatusCRs(ctx *syncContext, gvk schema.GroupVersionKind) error {
+	children := &unstructured.UnstructuredList{}
+	children.SetGroupVersionKind(gvk)
+	err := ctx.c.List(ctx, children, client.InNamespace(ctx.namespace), client.MatchingLabels{
+		common.UID_LABEL: string(ctx.parent.GetUID()),
+	})
+	if err != nil {
+		return err
+	}
+	for i := range children.Items {
+		child := &children.Items[i]
+		childCtx := ctx.copy()
+		childCtx.parent = child
+		for _, childGvk := range statusChildKinds[gvk.Kind] {
+			if err = removeStatusCRs(childCtx, childGvk); err != nil {
+				return err
+			}
+		}
+		if err = ctx.c.Delete(ctx, child); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setLocationSummary stores a compact, per-location view of the workload's deployments in its status. It is used
+// instead of the deployment, version and container status child CRs when the operator runs in summary status mode.
+func setLocationSummary(workload *unstructured.Unstructured, deployments []deployment.Deployment, deploymentCRs []*unstructured.Unstructured) {
+	var locations []any
+	for i, d := range deployments {
+		var versions []any
+		for _, v := range d.Status.Versions {
+			versions = append(versions, summarizeVersion(v))
+		}
+		readyReplicas, totalReplicas := deploymentReplicas(d)
+		var phase string
+		if status, ok := deploymentCRs[i].Object["status"].(map[string]any); ok {
+			phase, _ = status["phase"].(string)
+		}
+		locations = append(locations, map[string]any{
+			"name":          d.Name,
+			"phase":         phase,
+			"ready":         d.Status.Ready,
+			"readyReplicas": readyReplicas,
+			"totalReplicas": totalReplicas,
+			"message":       d.Status.Message,
+			"versions":      versions,
+		})
+	}
+	if _, ok := workload.Object["status"].(map[string]any); !ok {
+		workload.Object["status"] = map[string]any{}
+	}
+	workload.Object["status"].(map[string]any)["locations"] = locations
+}
+
+// setHealthSummary fills status.health with location and replica counts across all of the workload's deployments.
+// It must be called after the workload's phase has been set.
+func setHealthSummary(workload *unstructured.Unstructured, deployments []deployment.Deployment, deploymentCRs []*unstructured.Unstructured) {
+	var readyLocations, readyReplicas, totalReplicas int64
+	for i, d := range deployments {
+		if !isUnhealthy(deploymentCRs[i]) && !isProgressing(deploymentCRs[i]) && !isSuspended(deploymentCRs[i]) {
+			readyLocations++
+		}
+		r, t := deploymentReplicas(d)
+		readyReplicas += r
+		totalReplicas += t
+	}
+	status := workload.Object["status"].(map[string]any)
+	phase, _ := status["phase"].(string)
+	status["health"] = map[string]any{
+		"readiness":      readiness(phase),
+		"readyLocations": readyLocations,
+		"totalLocations": int64(len(deployments)),
+		"readyReplicas":  readyReplicas,
+		"totalReplicas":  totalReplicas,
+	}
+}
+
+func readiness(phase string) string {
+	switch phase {
+	case "Ready":
+		return "ready"
+	case "Unhealthy":
+		return "unhealthy"
+	case "Suspended":
+		return "suspended"
+	default:
+		return "progressing"
+	}
+}
+
+func clearLocationSummary(workload *unstructured.Unstructured) {
+	if status, ok := workload.Object["status"].(map[string]any); ok {
+		delete(status, "locations")
+	}
+}
+
+func summarizeVersion(v deployment.DeploymentVersion) map[string]any {
+	var containers []any
+	for _, c := range v.Containers {
+		containers = append(containers, map[string]any{
+			"name":          c.Name,
+			"image":         c.Image,
+			"ready":         c.Ready,
+			"readyReplicas": int64(c.Resources.ReplicasReady),
+			"totalReplicas": int64(c.Resources.Replicas),
+			"message":       c.Message,
+		})
+	}
+	slices.SortFunc(containers, func(a, b any) int {
+		return strings.Compare(a.(map[string]any)["name"].(string), b.(map[string]any)["name"].(string))
+	})
+	return map[string]any{
+		"name":       v.Name,
+		"workload":   int64(v.Workload),
+		"ready":      v.Ready,
+		"message":    v.Message,
+		"zone":       v.Zone,
+		"containers": containers,
+	}
+}
+
+func deploymentReplicas(d deployment.Deployment) (int64, int64) {
+	var ready, total int64
+	for _, v := range d.Status.Versions {
+		r, t := versionReplicas(v)
+		ready += r
+		total += t
+	}
+	return ready, total
+}
+
+// versionReplicas reports the ready and total replica counts of a deployment version. All containers of a version
+// run in the same replicas, so the version is only as ready as its least ready container.
+func versionReplicas(v deployment.DeploymentVersion) (int64, int64) {
+	var ready, total int64
+	first := true
+	for _, c := range v.Containers {
+		r := int64(c.Resources.ReplicasReady)
+		if first || r < ready {
+			ready = r
+		}
+		total = max(total, int64(c.Resources.Replicas))
+		first = false
+	}
+	return ready, total
+}
@@ -0,0 +1,185 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	"github.com/controlplane-com/k8s-operator/pkg/realtime"
+	"github.com/controlplane-com/types-go/pkg/deployment"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// The status helpers are exported to the external test package so that fixtures are built by the same code the
+// controllers run.
+var (
+	Ready            = ready
+	Unhealthy        = unhealthy
+	Progressing      = progressing
+	Suspended        = suspended
+	AddErrorMessages = addErrorMessages
+	Synced           = synced
+	SyncFailed       = syncFailed
+	SetHealth        = setHealth
+	SetCondition     = setCondition
+	RemoveCondition  = removeCondition
+	IsParked         = isParked
+	NewFairQueue     = newFairQueue
+	RenderPlan       = renderPlan
+	WouldHaveDone    = wouldHaveDone
+	UnstructuredCR   = unstructuredCR
+)
+
+// The change feed looks CRs up through this index
+const RemoteIndex = remoteIndex
+
+// ChangeQueueSize is how many changed CRs the change feed queues before dropping them
+const ChangeQueueSize = changeQueueSize
+
+var IndexRemote = indexRemote
+
+// PollMoved runs one poll of the given kind and returns the names of the CRs that would be pulled
+func PollMoved(ctx context.Context, reader client.Reader, opts Options, gvk schema.GroupVersionKind) ([]string, error) {
+	opts = opts.withDefaults()
+	r := &controller{
+		gvk:           gvk,
+		opts:          opts,
+		HttpClient:    opts.HTTPClient,
+		cplnConnector: cpln.NewGenericConnector(opts.Credentials, opts.HTTPClient, opts.APIURL),
+	}
+	moved, err := newPoller(reader, r, nil).poll(ctx, logr.Discard())
+	var names []string
+	for _, cr := range moved {
+		names = append(names, cr.GetName())
+	}
+	return names, err
+}
+
+// ChangeFeed follows the Control Plane changes of the orgs of its subscribed CRs
+type ChangeFeed struct {
+	feed *changeFeed
+	// Events receives the changed CRs of the watched kind
+	Events <-chan event.GenericEvent
+	// Syncs holds the connection of each followed org
+	Syncs *realtime.Registry
+}
+
+// Subscribe follows the changes of the org of the CR
+func (f *ChangeFeed) Subscribe(ctx cpln.Context, cr client.Object) error {
+	return f.feed.subscribe(ctx, cr)
+}
+
+// Release stops following the org of the CR once no other CR needs it
+func (f *ChangeFeed) Release(cr client.Object) error {
+	return f.feed.release(cr.GetObjectKind().GroupVersionKind().Kind, client.ObjectKeyFromObject(cr))
+}
+
+// WatchChanges returns a change feed sending the changed CRs of the kind to its Events. The CRs are looked up through
+// RemoteIndex.
+func WatchChanges(reader client.Reader, opts Options, gvk schema.GroupVersionKind) *ChangeFeed {
+	opts = opts.withDefaults()
+	syncs := realtime.NewRegistry()
+	feed := newChangeFeed(reader, opts, syncs)
+	events := make(chan event.GenericEvent, changeQueueSize)
+	feed.watch(gvk, events)
+	return &ChangeFeed{feed: feed, Events: events, Syncs: syncs}
+}
+
+// HashRingOwner returns the owner lookup of a hash ring of the members
+func HashRingOwner(members []string) func(key string) string {
+	return newHashRing(members).owner
+}
+
+// NewShard returns the membership sync and the ownership check of a replica sharing c with the others
+func NewShard(c client.Client, opts Options) (sync func(context.Context) error, owns func(client.Object) bool) {
+	s := newShards(c, c, opts.withDefaults())
+	return s.sync, s.owns
+}
+
+// ShadowClient returns the client the extensions called for parent get in shadow mode, and a function draining the
+// writes it skipped
+func ShadowClient(c client.Client, parent *unstructured.Unstructured) (client.Client, func() []string) {
+	r := &controller{Client: c, gvk: parent.GroupVersionKind(), opts: Options{Shadow: true}}
+	s := r.shadowClient(parent)
+	return s, s.drain
+}
+
+// ResolveConflicts returns the CR a push would send to the Control Plane API at opts.APIURL, or nil if it's held
+func ResolveConflicts(ctx cpln.Context, opts Options, cr *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	opts = opts.withDefaults()
+	r := &controller{
+		gvk:           cr.GroupVersionKind(),
+		opts:          opts,
+		cplnConnector: cpln.NewGenericConnector(opts.Credentials, opts.HTTPClient, opts.APIURL),
+	}
+	live, err := r.liveResource(ctx, logr.Discard(), cr)
+	if err != nil {
+		return nil, err
+	}
+	return r.resolveConflicts(ctx, logr.Discard(), cr, live)
+}
+
+// PreserveUnmanaged returns the CR a push would send, with the unmanaged fields taken from the live resource
+func PreserveUnmanaged(opts Options, cr *unstructured.Unstructured, live map[string]any) *unstructured.Unstructured {
+	r := &controller{gvk: cr.GroupVersionKind(), opts: opts.withDefaults()}
+	return r.preserveUnmanaged(cr, live)
+}
+
+var WithoutUnmanaged = withoutUnmanaged
+
+// RemoteDeleted handles the CR in c as if its resource was just found deleted in Control Plane
+func RemoteDeleted(ctx cpln.Context, c client.Client, recorder record.EventRecorder, opts Options, cr *unstructured.Unstructured) (ctrl.Result, error) {
+	r := &controller{
+		Client:       c,
+		gvk:          cr.GroupVersionKind(),
+		opts:         opts.withDefaults(),
+		k8sConnector: NewGenericConnector(cr.GroupVersionKind(), c),
+		recorder:     recorder,
+	}
+	return r.remoteDeleted(ctx, logr.Discard(), cr)
+}
+
+// DeletionGuard applies the deletion policy of the kind to the deleted CR in c
+func DeletionGuard(ctx cpln.Context, c client.Client, recorder record.EventRecorder, opts Options, cr *unstructured.Unstructured) (keep bool, held bool, err error) {
+	r := &controller{
+		Client:       c,
+		gvk:          cr.GroupVersionKind(),
+		opts:         opts.withDefaults(),
+		k8sConnector: NewGenericConnector(cr.GroupVersionKind(), c),
+		recorder:     recorder,
+	}
+	return r.deletionGuard(ctx, logr.Discard(), cr)
+}
+
+// NewReconciler returns the controller of the kind, reading CRs from c and syncing them with the Control Plane API at
+// opts.APIURL. With opts.Sharding, it's a replica that hasn't synced its membership yet, so it owns no CR.
+func NewReconciler(c client.Client, opts Options, gvk schema.GroupVersionKind) reconcile.Reconciler {
+	opts = opts.withDefaults()
+	r := &controller{
+		Client:        c,
+		gvk:           gvk,
+		opts:          opts,
+		HttpClient:    opts.HTTPClient,
+		cplnConnector: cpln.NewGenericConnector(opts.Credentials, opts.HTTPClient, opts.APIURL),
+		k8sConnector:  NewGenericConnector(gvk, c),
+		syncs:         realtime.NewRegistry(),
+	}
+	if opts.Sharding {
+		r.shards = newShards(c, c, opts)
+	}
+	return r
+}
+
+// SyncWorkloadDeployments syncs the status of the workload in c from its deployments, as the workload status feed does
+func SyncWorkloadDeployments(ctx context.Context, c client.Client, opts Options, workload *unstructured.Unstructured, deployments []deployment.Deployment) error {
+	syncCtx := newSyncContext(ctx, c)
+	syncCtx.parent = workload
+	syncCtx.namespace = workload.GetNamespace()
+	return syncWorkloadDeployments(&ExtensionContext{Client: c, Options: opts.withDefaults()}, syncCtx, deployments)
+}
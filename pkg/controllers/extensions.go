@@ -0,0 +1,148 @@
+package controllers
+
+import (
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	"github.com/controlplane-com/k8s-operator/pkg/realtime"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ExtensionContext gives kind extensions access to the operator while a resource is reconciled
+type ExtensionContext struct {
+	cpln.Context
+	Client    client.Client
+	Connector cpln.Connector
+	Options   Options
+	Syncs     *realtime.Registry
+}
+
+// ChildSyncFunc keeps the child resources of a CR in sync. It runs on every reconcile, before the CR itself is synced.
+type ChildSyncFunc func(ctx *ExtensionContext, cr *unstructured.Unstructured) error
+
+// CleanupFunc releases whatever a ChildSyncFunc started, once the CR is being deleted or handed over to another shard
+type CleanupFunc func(ctx *ExtensionContext, cr *unstructured.Unstructured) error
+
+// ActionOverrideFunc applies the overrides requested by action annotations (e.g. cpln.io/suspend) to a copy of the CR
+// that is about to be pushed. The CR itself is left untouched, so the overrides never end up in Kubernetes.
+type ActionOverrideFunc func(cr *unstructured.Unstructured)
+
+// ActionFunc performs the one-off actions requested by action annotations (e.g. cpln.io/run-now-requested-at). It
+// runs after the CR was pushed because an action annotation changed.
+type ActionFunc func(ctx *ExtensionContext, cr *unstructured.Unstructured) error
+
+// Health is the outcome of a HealthEvaluator
+type Health struct {
+	// Phase is one of the Phase* constants. An empty phase leaves the health of the CR untouched.
+	Phase string
+	// Message explains the phase, or warns about a ready resource. It is stored in status.operator.healthStatusMessage.
+	Message string
+}
+
+const (
+	PhaseReady       = "Ready"
+	PhaseUnhealthy   = "Unhealthy"
+	PhaseProgressing = "Pending"
+	PhaseSuspended   = "Suspended"
+)
+
+// HealthEvaluator derives the health of a CR from the Control Plane status stored in it. It runs after every
+// successful sync.
+type HealthEvaluator func(cr *unstructured.Unstructured) Health
+
+// KindExtension customizes how the operator handles one kind. Nil fields keep the generic behavior.
+type KindExtension struct {
+	// Converter replaces the generic conversion between the CR and the Control Plane resource
+	Converter cpln.Converter
+	// UrlProvider replaces the generic Control Plane API URLs of the kind
+	UrlProvider    cpln.UrlProvider
+	SyncChildren   ChildSyncFunc
+	Cleanup        CleanupFunc
+	EvaluateHealth HealthEvaluator
+	ApplyActions   ActionOverrideFunc
+	RunActions     ActionFunc
+	// UnmanagedFields are the JSON pointers of the fields of the kind that are managed in Control Plane only, e.g.
+	// /spec/defaultOptions/autoscaling/minScale
+	UnmanagedFields []string
+}
+
+// Extensions maps a kind to its KindExtension
+type Extensions map[string]KindExtension
+
+// DefaultExtensions returns the extensions of the built-in kinds
+func DefaultExtensions() Extensions {
+	return Extensions{
+		common.KIND_WORKLOAD: {
+			SyncChildren: syncWorkloadChildren,
+			Cleanup:      cleanupWorkloadChildren,
+			ApplyActions: applyWorkloadActions,
+			RunActions:   runWorkloadActions,
+		},
+		common.KIND_VOLUME_SET: {
+			SyncChildren: syncVolumeSetChildren,
+		},
+		common.KIND_AGENT:         {EvaluateHealth: evaluateAgentHealth},
+		common.KIND_CLOUD_ACCOUNT: {EvaluateHealth: evaluateCloudAccountHealth},
+		common.KIND_DOMAIN:        {EvaluateHealth: evaluateDomainHealth},
+		common.KIND_GVC:           {EvaluateHealth: evaluateGvcHealth},
+		common.KIND_IDENTITY:      {EvaluateHealth: evaluateIdentityHealth},
+		common.KIND_MK8S:          {EvaluateHealth: evaluateMk8sHealth},
+	}
+}
+
+// Register sets the non-nil fields of ext on the extension of kind, keeping the others
+func (e Extensions) Register(kind string, ext KindExtension) {
+	current := e[kind]
+	if ext.Converter != nil {
+		current.Converter = ext.Converter
+	}
+	if ext.UrlProvider != nil {
+		current.UrlProvider = ext.UrlProvider
+	}
+	if ext.SyncChildren != nil {
+		current.SyncChildren = ext.SyncChildren
+	}
+	if ext.Cleanup != nil {
+		current.Cleanup = ext.Cleanup
+	}
+	if ext.EvaluateHealth != nil {
+		current.EvaluateHealth = ext.EvaluateHealth
+	}
+	if ext.ApplyActions != nil {
+		current.ApplyActions = ext.ApplyActions
+	}
+	if ext.RunActions != nil {
+		current.RunActions = ext.RunActions
+	}
+	if ext.UnmanagedFields != nil {
+		current.UnmanagedFields = ext.UnmanagedFields
+	}
+	e[kind] = current
+}
+
+// apply injects the connector overrides of the extension
+func (ext KindExtension) apply(connector cpln.Connector) {
+	if ext.Converter != nil {
+		connector.InjectConverter(ext.Converter)
+	}
+	if ext.UrlProvider != nil {
+		connector.InjectUrlProvider(ext.UrlProvider)
+	}
+}
+
+// setHealth records the outcome of a HealthEvaluator on the CR
+func setHealth(cr *unstructured.Unstructured, h Health) {
+	switch h.Phase {
+	case PhaseReady:
+		ready(cr)
+	case PhaseUnhealthy:
+		unhealthy(cr)
+	case PhaseProgressing:
+		progressing(cr)
+	case PhaseSuspended:
+		suspended(cr)
+	default:
+		return
+	}
+	addErrorMessages(cr, h.Message)
+}
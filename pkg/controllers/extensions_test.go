@@ -0,0 +1,72 @@
+package controllers_test
+
+import (
+	"testing"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/controllers"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestExtensionsRegister(t *testing.T) {
+	// Scenario: Registering a health evaluator for a built-in kind keeps its child sync.
+	extensions := controllers.DefaultExtensions()
+	evaluate := func(cr *unstructured.Unstructured) controllers.Health {
+		return controllers.Health{Phase: controllers.PhaseReady}
+	}
+	extensions.Register(common.KIND_WORKLOAD, controllers.KindExtension{EvaluateHealth: evaluate})
+
+	workload := extensions[common.KIND_WORKLOAD]
+	if workload.EvaluateHealth == nil {
+		t.Errorf("Register did not set EvaluateHealth")
+	}
+	if workload.SyncChildren == nil || workload.Cleanup == nil {
+		t.Errorf("Register dropped the default SyncChildren/Cleanup of %s", common.KIND_WORKLOAD)
+	}
+
+	// Scenario: Registering a new kind adds it without touching the others.
+	extensions.Register("mykind", controllers.KindExtension{EvaluateHealth: evaluate})
+	if extensions["mykind"].EvaluateHealth == nil {
+		t.Errorf("Register did not add mykind")
+	}
+	if extensions[common.KIND_VOLUME_SET].SyncChildren == nil {
+		t.Errorf("Register dropped the default SyncChildren of %s", common.KIND_VOLUME_SET)
+	}
+}
+
+func TestWorkloadActions(t *testing.T) {
+	apply := controllers.DefaultExtensions()[common.KIND_WORKLOAD].ApplyActions
+	if apply == nil {
+		t.Fatalf("no action overrides registered for %s", common.KIND_WORKLOAD)
+	}
+
+	// Scenario: Restart and suspend are applied to the pushed workload.
+	cr := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"localOptions": []any{map[string]any{"location": "aws-us-west-2"}},
+		},
+	}}
+	cr.SetAnnotations(map[string]string{
+		common.RESTART_REQUESTED_AT_ANNOTATION: "2024-01-01T00:00:00Z",
+		common.SUSPEND_ANNOTATION:              "true",
+	})
+	apply(cr)
+	if tag, _, _ := unstructured.NestedString(cr.Object, "tags", "cpln/deployTimestamp"); tag != "2024-01-01T00:00:00Z" {
+		t.Errorf("deploy timestamp = %q, want the requested restart time", tag)
+	}
+	if suspend, _, _ := unstructured.NestedBool(cr.Object, "spec", "defaultOptions", "suspend"); !suspend {
+		t.Errorf("defaultOptions.suspend was not set")
+	}
+	localOptions, _, _ := unstructured.NestedSlice(cr.Object, "spec", "localOptions")
+	if suspend := localOptions[0].(map[string]any)["suspend"]; suspend != true {
+		t.Errorf("localOptions[0].suspend = %v, want true", suspend)
+	}
+
+	// Scenario: Unsuspending leaves the spec alone.
+	cr = &unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{}}}
+	cr.SetAnnotations(map[string]string{common.SUSPEND_ANNOTATION: "false"})
+	apply(cr)
+	if _, found, _ := unstructured.NestedFieldNoCopy(cr.Object, "spec", "defaultOptions"); found {
+		t.Errorf("unsuspended workload got defaultOptions")
+	}
+}
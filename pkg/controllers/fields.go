@@ -0,0 +1,198 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// unmanagedFields returns the JSON pointers of the fields of the CR that are managed in Control Plane only: those of
+// its kind, followed by those of its cpln.io/unmanaged-fields annotation. Like ignoredFields, they are never pushed,
+// and never pulled into the CR.
+func (r *controller) unmanagedFields(cr *unstructured.Unstructured) []string {
+	fields := slices.Clone(r.extension().UnmanagedFields)
+	fields = append(fields, r.opts.UnmanagedFields[r.gvk.Kind]...)
+	fields = append(fields, parsePointers(cr.GetAnnotations()[common.UNMANAGED_FIELDS_ANNOTATION])...)
+	return fields
+}
+
+// parsePointers parses a list of JSON pointers, given either as a JSON array or separated by commas. Anything that
+// isn't a JSON pointer is skipped.
+func parsePointers(list string) []string {
+	list = strings.TrimSpace(list)
+	var pointers []string
+	if strings.HasPrefix(list, "[") {
+		if err := json.Unmarshal([]byte(list), &pointers); err != nil {
+			return nil
+		}
+	} else {
+		pointers = strings.Split(list, ",")
+	}
+	var valid []string
+	for _, p := range pointers {
+		if p = strings.TrimSpace(p); strings.HasPrefix(p, "/") {
+			valid = append(valid, p)
+		}
+	}
+	return valid
+}
+
+// pointerTokens splits a JSON pointer into its unescaped reference tokens
+func pointerTokens(pointer string) []string {
+	tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, t := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+	return tokens
+}
+
+// pointerGet returns the value the JSON pointer refers to in a JSON object
+func pointerGet(obj map[string]any, pointer string) (any, bool) {
+	var current any = obj
+	for _, t := range pointerTokens(pointer) {
+		switch c := current.(type) {
+		case map[string]any:
+			v, ok := c[t]
+			if !ok {
+				return nil, false
+			}
+			current = v
+		case []any:
+			i, err := strconv.Atoi(t)
+			if err != nil || i < 0 || i >= len(c) {
+				return nil, false
+			}
+			current = c[i]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// pointerSet sets the value the JSON pointer refers to in a JSON object, adding the missing objects on the way. It
+// reports whether the value was set, which fails when the pointer runs through anything but objects and existing list
+// items.
+func pointerSet(obj map[string]any, pointer string, value any) bool {
+	tokens := pointerTokens(pointer)
+	var current any = obj
+	for i, t := range tokens {
+		last := i == len(tokens)-1
+		switch c := current.(type) {
+		case map[string]any:
+			if last {
+				c[t] = value
+				return true
+			}
+			next, ok := c[t]
+			if !ok {
+				next = map[string]any{}
+				c[t] = next
+			}
+			current = next
+		case []any:
+			index, err := strconv.Atoi(t)
+			if err != nil || index < 0 || index >= len(c) {
+				return false
+			}
+			if last {
+				c[index] = value
+				return true
+			}
+			current = c[index]
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// pointerDelete removes the value the JSON pointer refers to from a JSON object. Objects left empty on the way are
+// removed too. List items are never removed, so that the other items keep their place.
+func pointerDelete(obj map[string]any, pointer string) {
+	deleteTokens(obj, pointerTokens(pointer))
+}
+
+func deleteTokens(obj map[string]any, tokens []string) {
+	next, ok := obj[tokens[0]]
+	if !ok {
+		return
+	}
+	if len(tokens) == 1 {
+		delete(obj, tokens[0])
+		return
+	}
+	switch n := next.(type) {
+	case map[string]any:
+		deleteTokens(n, tokens[1:])
+		if len(n) == 0 {
+			delete(obj, tokens[0])
+		}
+	case []any:
+		i, err := strconv.Atoi(tokens[1])
+		if err != nil || i < 0 || i >= len(n) {
+			return
+		}
+		if item, ok := n[i].(map[string]any); ok && len(tokens) > 2 {
+			deleteTokens(item, tokens[2:])
+		}
+	}
+}
+
+// withoutUnmanaged returns a copy of a JSON object without the unmanaged fields
+func withoutUnmanaged(obj map[string]any, fields []string) map[string]any {
+	if len(fields) == 0 {
+		return obj
+	}
+	out := runtime.DeepCopyJSON(obj)
+	for _, f := range fields {
+		pointerDelete(out, f)
+	}
+	return out
+}
+
+// preserveUnmanaged returns the CR to push, with the unmanaged fields taken from the live Control Plane resource, so
+// that the push leaves them as they are. Fields missing from the live resource are left out. The CR itself is left
+// untouched. A nil live resource, which doesn't exist yet, takes the fields from the CR.
+func (r *controller) preserveUnmanaged(cr *unstructured.Unstructured, live map[string]any) *unstructured.Unstructured {
+	fields := r.unmanagedFields(cr)
+	if len(fields) == 0 || live == nil {
+		return cr
+	}
+	cr = cr.DeepCopy()
+	for _, f := range fields {
+		if v, ok := pointerGet(live, f); ok {
+			pointerSet(cr.Object, f, runtime.DeepCopyJSONValue(v))
+		} else {
+			pointerDelete(cr.Object, f)
+		}
+	}
+	return cr
+}
+
+// liveResource returns the Control Plane resource of the CR, or nil if it doesn't exist
+func (r *controller) liveResource(ctx cpln.Context, log logr.Logger, cr *unstructured.Unstructured) (map[string]any, error) {
+	cplnResource, err := r.cplnConnector.Get(ctx, cr)
+	if errors.Is(err, common.NotFoundError) {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error(err, "Error fetching from Control Plane")
+		return nil, err
+	}
+	live := map[string]any{}
+	if err := json.Unmarshal(cplnResource, &live); err != nil {
+		log.Error(err, fmt.Sprintf("could not unmarshal response from Control Plane: %s", cplnResource))
+		return nil, err
+	}
+	return live, nil
+}
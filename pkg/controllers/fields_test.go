@@ -0,0 +1,78 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/controllers"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestUnmanagedFields(t *testing.T) {
+	parse := func(s string) map[string]any {
+		m := map[string]any{}
+		if err := json.Unmarshal([]byte(s), &m); err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+	newCR := func(annotation string) *unstructured.Unstructured {
+		cr := &unstructured.Unstructured{Object: parse(`{
+			"spec": {
+				"defaultOptions": {"suspend": false, "autoscaling": {"minScale": 1, "maxScale": 3}},
+				"firewallConfig": {"external": {"inboundAllowCIDR": ["0.0.0.0/0"]}},
+				"containers": [{"name": "main", "image": "api:2"}]
+			}
+		}`)}
+		cr.SetGroupVersionKind(schema.GroupVersionKind{Group: common.API_GROUP, Version: common.API_REVISION, Kind: common.KIND_WORKLOAD})
+		cr.SetAnnotations(map[string]string{common.UNMANAGED_FIELDS_ANNOTATION: annotation})
+		return cr
+	}
+	live := parse(`{
+		"spec": {
+			"defaultOptions": {"autoscaling": {"minScale": 2, "maxScale": 5}},
+			"firewallConfig": {"external": {"inboundAllowCIDR": ["10.0.0.0/8"]}},
+			"containers": [{"name": "main", "image": "api:1", "cpu": "100m"}]
+		}
+	}`)
+	opts := controllers.Options{UnmanagedFields: map[string][]string{
+		common.KIND_WORKLOAD: {"/spec/defaultOptions/autoscaling/minScale"},
+	}}
+
+	// Scenario: The fields of the kind and of the annotation are taken from the live resource. Fields missing from it
+	// are left out, and the CR itself is left untouched.
+	cr := newCR("/spec/defaultOptions/suspend, /spec/firewallConfig,/spec/containers/0/cpu, not-a-pointer")
+	pushed := controllers.PreserveUnmanaged(opts, cr, live)
+	want := parse(`{
+		"defaultOptions": {"autoscaling": {"minScale": 2, "maxScale": 3}},
+		"firewallConfig": {"external": {"inboundAllowCIDR": ["10.0.0.0/8"]}},
+		"containers": [{"name": "main", "image": "api:2", "cpu": "100m"}]
+	}`)
+	if got := pushed.Object["spec"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("pushed spec = %v, want %v", got, want)
+	}
+	if suspend, _, _ := unstructured.NestedBool(cr.Object, "spec", "defaultOptions", "suspend"); suspend || cr.Object["spec"].(map[string]any)["firewallConfig"] == nil {
+		t.Errorf("the CR was changed: %v", cr.Object["spec"])
+	}
+
+	// Scenario: The annotation can also be a JSON array.
+	pushed = controllers.PreserveUnmanaged(controllers.Options{}, newCR(`["/spec/firewallConfig/external"]`), live)
+	if got, _, _ := unstructured.NestedStringSlice(pushed.Object, "spec", "firewallConfig", "external", "inboundAllowCIDR"); !reflect.DeepEqual(got, []string{"10.0.0.0/8"}) {
+		t.Errorf("inboundAllowCIDR = %v, want the live value", got)
+	}
+
+	// Scenario: A resource that doesn't exist yet is created with the values of the CR.
+	cr = newCR("/spec/defaultOptions/suspend")
+	if pushed := controllers.PreserveUnmanaged(opts, cr, nil); pushed != cr {
+		t.Errorf("a new resource was pushed without the values of the CR")
+	}
+
+	// Scenario: Removing unmanaged fields drops the objects they leave empty, and unescapes ~1 and ~0.
+	got := controllers.WithoutUnmanaged(parse(`{"tags": {"a/b": "x"}, "spec": {"suspend": true}}`), []string{"/tags/a~1b", "/spec/suspend"})
+	if len(got) != 0 {
+		t.Errorf("left = %v, want nothing", got)
+	}
+}
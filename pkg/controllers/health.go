@@ -0,0 +1,173 @@
+package controllers
+
+import (
+	"fmt"
+	"strings"
+
+	cplnv1 "github.com/controlplane-com/k8s-operator/pkg/apis/cpln/v1"
+	"github.com/controlplane-com/types-go/pkg/domain"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// The evaluators below read the Control Plane status stored in the CR. A CR that can't be decoded keeps its current
+// health, since the sync itself succeeded.
+
+func fromUnstructured[T any](cr *unstructured.Unstructured) (*T, bool) {
+	typed := new(T)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(cr.Object, typed); err != nil {
+		return nil, false
+	}
+	return typed, true
+}
+
+// evaluateDomainHealth follows the domain's DNS and certificate state
+func evaluateDomainHealth(cr *unstructured.Unstructured) Health {
+	d, ok := fromUnstructured[cplnv1.Domain](cr)
+	if !ok {
+		return Health{}
+	}
+	st := d.Status.DomainStatus
+	switch st.Status {
+	case domain.DomainStatusStatusReady, domain.DomainStatusStatusUsedByGvc:
+		for _, l := range st.Locations {
+			if l.CertificateStatus != "" && l.CertificateStatus != domain.DomainStatusLocationsCertificateStatusReady &&
+				l.CertificateStatus != domain.DomainStatusLocationsCertificateStatusIgnored {
+				return Health{Phase: PhaseProgressing, Message: fmt.Sprintf("The certificate in location %s is %s.", l.Name, l.CertificateStatus)}
+			}
+		}
+		return Health{Phase: PhaseReady}
+	case domain.DomainStatusStatusPendingDnsConfig:
+		var records []string
+		for _, r := range st.DnsConfig {
+			records = append(records, fmt.Sprintf("%s %s %s", r.Type, r.Host, r.Value))
+		}
+		return Health{Phase: PhaseProgressing, Message: "Waiting for DNS records: " + strings.Join(records, ", ")}
+	case domain.DomainStatusStatusPendingCertificate:
+		return Health{Phase: PhaseProgressing, Message: "Waiting for the certificate to be issued."}
+	case domain.DomainStatusStatusWarning:
+		//The domain still serves traffic, so the warning doesn't make it unhealthy
+		message := st.Warning
+		if message == "" {
+			message = "The domain has a warning."
+		}
+		return Health{Phase: PhaseReady, Message: message}
+	case domain.DomainStatusStatusErrored:
+		message := st.Warning
+		if message == "" {
+			message = fmt.Sprintf("The domain is in %s state.", st.Status)
+		}
+		return Health{Phase: PhaseUnhealthy, Message: message}
+	case domain.DomainStatusStatusIgnored:
+		return Health{Phase: PhaseSuspended, Message: "The domain is ignored by Control Plane."}
+	default:
+		return Health{Phase: PhaseProgressing, Message: "The domain is initializing."}
+	}
+}
+
+// evaluateGvcHealth reports a GVC without locations as suspended, since nothing in it can run
+func evaluateGvcHealth(cr *unstructured.Unstructured) Health {
+	g, ok := fromUnstructured[cplnv1.Gvc](cr)
+	if !ok {
+		return Health{}
+	}
+	if g.Spec == nil || g.Spec.StaticPlacement == nil ||
+		(len(g.Spec.StaticPlacement.LocationLinks) == 0 && g.Spec.StaticPlacement.LocationQuery == nil) {
+		return Health{Phase: PhaseSuspended, Message: "The GVC has no locations."}
+	}
+	return Health{Phase: PhaseReady}
+}
+
+// evaluateIdentityHealth checks that each cloud provider configured on the identity is usable
+func evaluateIdentityHealth(cr *unstructured.Unstructured) Health {
+	i, ok := fromUnstructured[cplnv1.Identity](cr)
+	if !ok {
+		return Health{}
+	}
+	type provider struct {
+		name       string
+		configured bool
+		usable     bool
+		lastError  string
+	}
+	providers := []provider{
+		{"aws", i.Aws != nil, i.Status.Aws.Usable, i.Status.Aws.LastError},
+		{"gcp", i.Gcp != nil, i.Status.Gcp.Usable, i.Status.Gcp.LastError},
+		{"azure", i.Azure != nil, i.Status.Azure.Usable, i.Status.Azure.LastError},
+	}
+	var errs, pending []string
+	for _, p := range providers {
+		switch {
+		case !p.configured || p.usable:
+		case p.lastError != "":
+			errs = append(errs, fmt.Sprintf("%s: %s", p.name, p.lastError))
+		default:
+			pending = append(pending, p.name)
+		}
+	}
+	if len(errs) > 0 {
+		return Health{Phase: PhaseUnhealthy, Message: strings.Join(errs, "\n")}
+	}
+	if len(pending) > 0 {
+		return Health{Phase: PhaseProgressing, Message: fmt.Sprintf("Waiting for the %s identity to become usable.", strings.Join(pending, ", "))}
+	}
+	return Health{Phase: PhaseReady}
+}
+
+// mk8sAddOnStatuses are the add-ons that report a status once they are installed on the cluster
+var mk8sAddOnStatuses = []string{"dashboard", "headlamp", "awsWorkloadIdentity", "metrics", "logs", "awsECR", "awsEFS", "awsELB"}
+
+// evaluateMk8sHealth reports a cluster as progressing until its provider brought up the API server, and every add-on
+// enabled in the spec reported its status
+func evaluateMk8sHealth(cr *unstructured.Unstructured) Health {
+	c, ok := fromUnstructured[cplnv1.Mk8sCluster](cr)
+	if !ok {
+		return Health{}
+	}
+	if c.Status.ServerUrl == "" {
+		return Health{Phase: PhaseProgressing, Message: "The cluster is being provisioned."}
+	}
+	enabled, _, _ := unstructured.NestedMap(cr.Object, "spec", "addOns")
+	reported, _, _ := unstructured.NestedMap(cr.Object, "status", "addOns")
+	var pending []string
+	for _, addOn := range mk8sAddOnStatuses {
+		if _, ok := enabled[addOn]; !ok {
+			continue
+		}
+		if st, _ := reported[addOn].(map[string]any); len(st) == 0 {
+			pending = append(pending, addOn)
+		}
+	}
+	if len(pending) > 0 {
+		return Health{Phase: PhaseProgressing, Message: fmt.Sprintf("Waiting for the %s add-ons to be installed.", strings.Join(pending, ", "))}
+	}
+	return Health{Phase: PhaseReady}
+}
+
+// evaluateAgentHealth reports an agent as progressing until it has connected and reported its protocol version
+func evaluateAgentHealth(cr *unstructured.Unstructured) Health {
+	protocolVersion, _, _ := unstructured.NestedString(cr.Object, "status", "protocolVersion")
+	if protocolVersion == "" {
+		return Health{Phase: PhaseProgressing, Message: "The agent has not connected yet."}
+	}
+	return Health{Phase: PhaseReady}
+}
+
+// evaluateCloudAccountHealth follows the result of Control Plane's last check of the account
+func evaluateCloudAccountHealth(cr *unstructured.Unstructured) Health {
+	a, ok := fromUnstructured[cplnv1.CloudAccount](cr)
+	if !ok {
+		return Health{}
+	}
+	st := a.Status.CloudAccountStatus
+	switch {
+	case st.Usable:
+		return Health{Phase: PhaseReady}
+	case st.LastError != "":
+		return Health{Phase: PhaseUnhealthy, Message: st.LastError}
+	case st.LastChecked == "":
+		return Health{Phase: PhaseProgressing, Message: "The cloud account has not been checked yet."}
+	default:
+		return Health{Phase: PhaseUnhealthy, Message: "The cloud account is not usable."}
+	}
+}
@@ -0,0 +1,120 @@
+package controllers_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/controllers"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDefaultHealthEvaluators(t *testing.T) {
+	extensions := controllers.DefaultExtensions()
+	tests := []struct {
+		name    string
+		kind    string
+		object  map[string]any
+		phase   string
+		message string
+	}{
+		{
+			name: "domain waiting on DNS",
+			kind: common.KIND_DOMAIN,
+			object: map[string]any{"status": map[string]any{
+				"status":    "pendingDnsConfig",
+				"dnsConfig": []any{map[string]any{"type": "CNAME", "host": "www", "value": "example.cpln.app"}},
+			}},
+			phase:   controllers.PhaseProgressing,
+			message: "CNAME www example.cpln.app",
+		},
+		{
+			name:    "domain failed validation",
+			kind:    common.KIND_DOMAIN,
+			object:  map[string]any{"status": map[string]any{"status": "errored", "warning": "domain is not owned by org"}},
+			phase:   controllers.PhaseUnhealthy,
+			message: "domain is not owned by org",
+		},
+		{
+			name:    "domain with a warning",
+			kind:    common.KIND_DOMAIN,
+			object:  map[string]any{"status": map[string]any{"status": "warning", "warning": "the apex record is missing"}},
+			phase:   controllers.PhaseReady,
+			message: "the apex record is missing",
+		},
+		{
+			name:   "domain ready",
+			kind:   common.KIND_DOMAIN,
+			object: map[string]any{"status": map[string]any{"status": "ready"}},
+			phase:  controllers.PhaseReady,
+		},
+		{
+			name:   "gvc without locations",
+			kind:   common.KIND_GVC,
+			object: map[string]any{"spec": map[string]any{}},
+			phase:  controllers.PhaseSuspended,
+		},
+		{
+			name: "identity with an unusable aws provider",
+			kind: common.KIND_IDENTITY,
+			object: map[string]any{
+				"aws":    map[string]any{"cloudAccountLink": "//cloudaccount/aws"},
+				"status": map[string]any{"aws": map[string]any{"usable": false, "lastError": "access denied"}},
+			},
+			phase:   controllers.PhaseUnhealthy,
+			message: "aws: access denied",
+		},
+		{
+			name: "mk8s cluster waiting on an add-on",
+			kind: common.KIND_MK8S,
+			object: map[string]any{
+				"spec": map[string]any{"addOns": map[string]any{"dashboard": map[string]any{}, "logs": map[string]any{}}},
+				"status": map[string]any{
+					"serverUrl": "https://cluster.example.com",
+					"addOns":    map[string]any{"dashboard": map[string]any{"url": "https://dashboard.example.com"}},
+				},
+			},
+			phase:   controllers.PhaseProgressing,
+			message: "logs",
+		},
+		{
+			name: "mk8s cluster with its add-ons installed",
+			kind: common.KIND_MK8S,
+			object: map[string]any{
+				"spec": map[string]any{"addOns": map[string]any{"dashboard": map[string]any{}, "nvidia": map[string]any{}}},
+				"status": map[string]any{
+					"serverUrl": "https://cluster.example.com",
+					"addOns":    map[string]any{"dashboard": map[string]any{"url": "https://dashboard.example.com"}},
+				},
+			},
+			phase: controllers.PhaseReady,
+		},
+		{
+			name:   "agent not connected",
+			kind:   common.KIND_AGENT,
+			object: map[string]any{"status": map[string]any{}},
+			phase:  controllers.PhaseProgressing,
+		},
+		{
+			name:   "cloud account usable",
+			kind:   common.KIND_CLOUD_ACCOUNT,
+			object: map[string]any{"status": map[string]any{"usable": true}},
+			phase:  controllers.PhaseReady,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluate := extensions[tt.kind].EvaluateHealth
+			if evaluate == nil {
+				t.Fatalf("no health evaluator registered for %s", tt.kind)
+			}
+			h := evaluate(&unstructured.Unstructured{Object: tt.object})
+			if h.Phase != tt.phase {
+				t.Errorf("phase = %q, want %q", h.Phase, tt.phase)
+			}
+			if !strings.Contains(h.Message, tt.message) {
+				t.Errorf("message = %q, want it to contain %q", h.Message, tt.message)
+			}
+		})
+	}
+}
@@ -0,0 +1,486 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/controllers"
+	lua "github.com/yuin/gopher-lua"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const scriptsDir = "../../scripts"
+
+// healthScripts returns the generic health check plus every per-kind override, keyed by a name for the subtest. Every
+// script must honour the status contract of the operator, so all of them run against the same fixtures.
+func healthScripts(t *testing.T) map[string]string {
+	scripts := map[string]string{"healthCheck.lua": filepath.Join(scriptsDir, "healthCheck.lua")}
+	perKind, err := filepath.Glob(filepath.Join(scriptsDir, "health", "*.lua"))
+	if err != nil {
+		t.Fatalf("listing per-kind health scripts: %v", err)
+	}
+	for _, p := range perKind {
+		scripts["health/"+filepath.Base(p)] = p
+	}
+	return scripts
+}
+
+// runHealthCheck executes a health script the way Argo does: the CR is exposed as the global obj, and the script
+// returns a table with status and message.
+func runHealthCheck(t *testing.T, script string, cr *unstructured.Unstructured) (string, string) {
+	t.Helper()
+	// Round-trip through JSON, since Argo sees the object as the API server stores it
+	b, err := json.Marshal(cr.Object)
+	if err != nil {
+		t.Fatalf("marshalling fixture: %v", err)
+	}
+	var obj map[string]any
+	if err = json.Unmarshal(b, &obj); err != nil {
+		t.Fatalf("unmarshalling fixture: %v", err)
+	}
+
+	L := lua.NewState()
+	defer L.Close()
+	L.SetGlobal("obj", toLua(L, obj))
+	if err = L.DoString(script); err != nil {
+		t.Fatalf("running health script: %v", err)
+	}
+	hs, ok := L.Get(-1).(*lua.LTable)
+	if !ok {
+		t.Fatalf("health script returned %s, want a table", L.Get(-1).Type())
+	}
+	return hs.RawGetString("status").String(), hs.RawGetString("message").String()
+}
+
+func toLua(L *lua.LState, v any) lua.LValue {
+	switch val := v.(type) {
+	case nil:
+		return lua.LNil
+	case bool:
+		return lua.LBool(val)
+	case float64:
+		return lua.LNumber(val)
+	case string:
+		return lua.LString(val)
+	case []any:
+		t := L.NewTable()
+		for _, item := range val {
+			t.Append(toLua(L, item))
+		}
+		return t
+	case map[string]any:
+		t := L.NewTable()
+		for k, item := range val {
+			t.RawSetString(k, toLua(L, item))
+		}
+		return t
+	default:
+		return lua.LNil
+	}
+}
+
+func newFixture(kind string, gen int64) *unstructured.Unstructured {
+	cr := &unstructured.Unstructured{Object: map[string]any{}}
+	cr.SetAPIVersion(common.API_VERSION)
+	cr.SetKind(kind)
+	cr.SetName("test")
+	cr.SetNamespace("default")
+	cr.SetGeneration(gen)
+	return cr
+}
+
+// evaluated returns a fixture of the kind, synced with the Control Plane status and fields, whose health was evaluated
+// by the default evaluator of the kind
+func evaluated(kind string, fields, status map[string]any) func() *unstructured.Unstructured {
+	return func() *unstructured.Unstructured {
+		cr := newFixture(kind, 1)
+		for k, v := range fields {
+			cr.Object[k] = v
+		}
+		controllers.Synced(cr, false, status)
+		controllers.SetHealth(cr, controllers.DefaultExtensions()[kind].EvaluateHealth(cr))
+		return cr
+	}
+}
+
+// syncFailed returns a fixture of the kind whose last sync failed
+func syncFailed(kind string, err string) func() *unstructured.Unstructured {
+	return func() *unstructured.Unstructured {
+		cr := newFixture(kind, 2)
+		controllers.SyncFailed(cr, errors.New(err))
+		return cr
+	}
+}
+
+func TestHealthCheckScripts(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture func() *unstructured.Unstructured
+		status  string
+		message string
+	}{
+		{
+			name: "synced and ready",
+			fixture: func() *unstructured.Unstructured {
+				cr := newFixture(common.KIND_WORKLOAD, 1)
+				controllers.Synced(cr, false, nil)
+				controllers.Ready(cr)
+				return cr
+			},
+			status: "Healthy",
+		},
+		{
+			name: "synced without a phase",
+			fixture: func() *unstructured.Unstructured {
+				cr := newFixture(common.KIND_WORKLOAD, 3)
+				controllers.Synced(cr, false, nil)
+				return cr
+			},
+			status: "Healthy",
+		},
+		{
+			name: "synced and unhealthy",
+			fixture: func() *unstructured.Unstructured {
+				cr := newFixture(common.KIND_WORKLOAD, 1)
+				controllers.Synced(cr, false, nil)
+				controllers.Unhealthy(cr)
+				controllers.AddErrorMessages(cr, "container main is crash looping")
+				return cr
+			},
+			status:  "Degraded",
+			message: "container main is crash looping",
+		},
+		{
+			name: "synced and progressing",
+			fixture: func() *unstructured.Unstructured {
+				cr := newFixture(common.KIND_WORKLOAD, 1)
+				controllers.Synced(cr, false, nil)
+				controllers.Progressing(cr)
+				return cr
+			},
+			status: "Progressing",
+		},
+		{
+			name: "synced and suspended",
+			fixture: func() *unstructured.Unstructured {
+				cr := newFixture(common.KIND_WORKLOAD, 1)
+				controllers.Synced(cr, false, nil)
+				controllers.Suspended(cr)
+				return cr
+			},
+			status: "Suspended",
+		},
+		{
+			name: "sync failed",
+			fixture: func() *unstructured.Unstructured {
+				cr := newFixture(common.KIND_WORKLOAD, 2)
+				controllers.Synced(cr, false, nil)
+				controllers.Ready(cr)
+				controllers.SyncFailed(cr, errors.New("spec.containers is required"))
+				return cr
+			},
+			status:  "Degraded",
+			message: "spec.containers is required",
+		},
+		{
+			name: "sync failed while deleting",
+			fixture: func() *unstructured.Unstructured {
+				cr := newFixture(common.KIND_WORKLOAD, 1)
+				controllers.SyncFailed(cr, errors.New("unable to delete Control Plane resource"))
+				cr.Object["metadata"].(map[string]any)["deletionTimestamp"] = "2024-01-01T00:00:00Z"
+				return cr
+			},
+			status:  "Progressing",
+			message: "unable to delete Control Plane resource",
+		},
+		{
+			name: "new generation not yet synced",
+			fixture: func() *unstructured.Unstructured {
+				cr := newFixture(common.KIND_WORKLOAD, 1)
+				controllers.Synced(cr, false, nil)
+				controllers.Ready(cr)
+				cr.SetGeneration(2)
+				return cr
+			},
+			status: "Progressing",
+		},
+		{
+			name: "never synced",
+			fixture: func() *unstructured.Unstructured {
+				return newFixture(common.KIND_WORKLOAD, 1)
+			},
+			status: "Progressing",
+		},
+		{
+			name: "downstream only",
+			fixture: func() *unstructured.Unstructured {
+				cr := newFixture("deployment", 1)
+				controllers.Synced(cr, true, nil)
+				cr.SetGeneration(5)
+				controllers.Ready(cr)
+				return cr
+			},
+			status: "Healthy",
+		},
+		{
+			name: "health overridden by annotation",
+			fixture: func() *unstructured.Unstructured {
+				cr := newFixture(common.KIND_WORKLOAD, 1)
+				controllers.SyncFailed(cr, errors.New("ignored"))
+				cr.SetAnnotations(map[string]string{
+					"cpln.io/sync-health-status":  "Healthy",
+					"cpln.io/sync-health-message": "forced",
+				})
+				return cr
+			},
+			status:  "Healthy",
+			message: "forced",
+		},
+		{
+			name: "paused",
+			fixture: func() *unstructured.Unstructured {
+				cr := newFixture(common.KIND_WORKLOAD, 2)
+				controllers.Synced(cr, false, nil)
+				controllers.Ready(cr)
+				controllers.SetCondition(cr, controllers.ConditionPaused, "True", controllers.ReasonMaintenance, "maintenance")
+				return cr
+			},
+			status:  "Suspended",
+			message: "maintenance",
+		},
+		{
+			name: "resumed",
+			fixture: func() *unstructured.Unstructured {
+				cr := newFixture(common.KIND_WORKLOAD, 1)
+				controllers.Synced(cr, false, nil)
+				controllers.Ready(cr)
+				controllers.SetCondition(cr, controllers.ConditionPaused, "True", controllers.ReasonPausedByAnnotation, "")
+				controllers.RemoveCondition(cr, controllers.ConditionPaused)
+				return cr
+			},
+			status: "Healthy",
+		},
+		{
+			name: "domain waiting on DNS",
+			fixture: func() *unstructured.Unstructured {
+				cr := newFixture(common.KIND_DOMAIN, 1)
+				controllers.Synced(cr, false, map[string]any{
+					"status":    "pendingDnsConfig",
+					"dnsConfig": []any{map[string]any{"type": "TXT", "host": "_cpln", "value": "abc"}},
+				})
+				controllers.SetHealth(cr, controllers.DefaultExtensions()[common.KIND_DOMAIN].EvaluateHealth(cr))
+				return cr
+			},
+			status:  "Progressing",
+			message: "TXT _cpln abc",
+		},
+		{
+			name: "domain failed validation",
+			fixture: func() *unstructured.Unstructured {
+				cr := newFixture(common.KIND_DOMAIN, 1)
+				controllers.Synced(cr, false, map[string]any{"status": "errored", "warning": "domain is not owned by org"})
+				controllers.SetHealth(cr, controllers.DefaultExtensions()[common.KIND_DOMAIN].EvaluateHealth(cr))
+				return cr
+			},
+			status:  "Degraded",
+			message: "domain is not owned by org",
+		},
+		{
+			name:    "domain ready",
+			fixture: evaluated(common.KIND_DOMAIN, nil, map[string]any{"status": "ready"}),
+			status:  "Healthy",
+		},
+		{
+			name:    "domain with a warning",
+			fixture: evaluated(common.KIND_DOMAIN, nil, map[string]any{"status": "warning", "warning": "the apex record is missing"}),
+			status:  "Healthy",
+			message: "the apex record is missing",
+		},
+		{
+			name: "gvc with locations",
+			fixture: evaluated(common.KIND_GVC, map[string]any{
+				"spec": map[string]any{"staticPlacement": map[string]any{"locationLinks": []any{"//location/aws-us-west-2"}}},
+			}, nil),
+			status: "Healthy",
+		},
+		{
+			name:    "gvc without locations",
+			fixture: evaluated(common.KIND_GVC, map[string]any{"spec": map[string]any{}}, nil),
+			status:  "Suspended",
+		},
+		{
+			name:    "gvc failed to sync",
+			fixture: syncFailed(common.KIND_GVC, "location aws-mars-1 does not exist"),
+			status:  "Degraded",
+			message: "location aws-mars-1 does not exist",
+		},
+		{
+			name: "identity usable",
+			fixture: evaluated(common.KIND_IDENTITY, map[string]any{"aws": map[string]any{"cloudAccountLink": "//cloudaccount/aws"}},
+				map[string]any{"aws": map[string]any{"usable": true}}),
+			status: "Healthy",
+		},
+		{
+			name: "identity not usable yet",
+			fixture: evaluated(common.KIND_IDENTITY, map[string]any{"aws": map[string]any{"cloudAccountLink": "//cloudaccount/aws"}},
+				map[string]any{"aws": map[string]any{"usable": false}}),
+			status:  "Progressing",
+			message: "aws",
+		},
+		{
+			name: "identity with a provider error",
+			fixture: evaluated(common.KIND_IDENTITY, map[string]any{"aws": map[string]any{"cloudAccountLink": "//cloudaccount/aws"}},
+				map[string]any{"aws": map[string]any{"usable": false, "lastError": "access denied"}}),
+			status:  "Degraded",
+			message: "aws: access denied",
+		},
+		{
+			name: "mk8s cluster with its add-ons installed",
+			fixture: evaluated(common.KIND_MK8S, map[string]any{"spec": map[string]any{"addOns": map[string]any{"logs": map[string]any{}}}},
+				map[string]any{"serverUrl": "https://cluster.example.com", "addOns": map[string]any{"logs": map[string]any{"lokiAddress": "loki:3100"}}}),
+			status: "Healthy",
+		},
+		{
+			name:    "mk8s cluster being provisioned",
+			fixture: evaluated(common.KIND_MK8S, map[string]any{"spec": map[string]any{}}, map[string]any{}),
+			status:  "Progressing",
+			message: "provisioned",
+		},
+		{
+			name: "mk8s cluster waiting on an add-on",
+			fixture: evaluated(common.KIND_MK8S, map[string]any{"spec": map[string]any{"addOns": map[string]any{"logs": map[string]any{}}}},
+				map[string]any{"serverUrl": "https://cluster.example.com"}),
+			status:  "Progressing",
+			message: "logs",
+		},
+		{
+			name:    "mk8s cluster failed to sync",
+			fixture: syncFailed(common.KIND_MK8S, "provider.generic.location is required"),
+			status:  "Degraded",
+			message: "provider.generic.location is required",
+		},
+		{
+			name:    "agent connected",
+			fixture: evaluated(common.KIND_AGENT, nil, map[string]any{"protocolVersion": "1"}),
+			status:  "Healthy",
+		},
+		{
+			name:    "agent not connected",
+			fixture: evaluated(common.KIND_AGENT, nil, map[string]any{}),
+			status:  "Progressing",
+			message: "not connected",
+		},
+		{
+			name:    "agent failed to sync",
+			fixture: syncFailed(common.KIND_AGENT, "description is too long"),
+			status:  "Degraded",
+			message: "description is too long",
+		},
+		{
+			name:    "cloud account usable",
+			fixture: evaluated(common.KIND_CLOUD_ACCOUNT, nil, map[string]any{"usable": true}),
+			status:  "Healthy",
+		},
+		{
+			name:    "cloud account not checked yet",
+			fixture: evaluated(common.KIND_CLOUD_ACCOUNT, nil, map[string]any{}),
+			status:  "Progressing",
+			message: "not been checked",
+		},
+		{
+			name:    "cloud account not usable",
+			fixture: evaluated(common.KIND_CLOUD_ACCOUNT, nil, map[string]any{"usable": false, "lastError": "role not assumable"}),
+			status:  "Degraded",
+			message: "role not assumable",
+		},
+	}
+
+	for name, path := range healthScripts(t) {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("reading %s: %v", path, err)
+		}
+		script := string(b)
+		t.Run(name, func(t *testing.T) {
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					status, message := runHealthCheck(t, script, tt.fixture())
+					if status != tt.status {
+						t.Errorf("status = %q, want %q (message %q)", status, tt.status, message)
+					}
+					if !strings.Contains(message, tt.message) {
+						t.Errorf("message = %q, want it to contain %q", message, tt.message)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestActionScripts(t *testing.T) {
+	readScript := func(name string) string {
+		b, err := os.ReadFile(filepath.Join(scriptsDir, "actions", name))
+		if err != nil {
+			t.Fatalf("reading %s: %v", name, err)
+		}
+		return string(b)
+	}
+	discover := func(cr *unstructured.Unstructured) []string {
+		L := lua.NewState()
+		defer L.Close()
+		L.SetGlobal("obj", toLua(L, cr.Object))
+		if err := L.DoString(readScript("discovery.lua")); err != nil {
+			t.Fatalf("running discovery.lua: %v", err)
+		}
+		var names []string
+		L.Get(-1).(*lua.LTable).ForEach(func(k, _ lua.LValue) { names = append(names, k.String()) })
+		slices.Sort(names)
+		return names
+	}
+
+	// Scenario: A paused cron workload offers resume, unsuspend and run-now.
+	cron := newFixture(common.KIND_WORKLOAD, 1)
+	cron.Object["spec"] = map[string]any{"type": "cron"}
+	cron.SetAnnotations(map[string]string{common.PAUSED_ANNOTATION: "true", common.SUSPEND_ANNOTATION: "true"})
+	want := []string{"restart", "resume", "resync", "run-now", "unsuspend"}
+	if got := discover(cron); !slices.Equal(got, want) {
+		t.Errorf("cron workload actions = %v, want %v", got, want)
+	}
+
+	// Scenario: Other kinds only offer the common actions.
+	gvc := newFixture(common.KIND_GVC, 1)
+	want = []string{"pause", "resync"}
+	if got := discover(gvc); !slices.Equal(got, want) {
+		t.Errorf("gvc actions = %v, want %v", got, want)
+	}
+
+	// Scenario: Each action sets the annotation the operator reacts to.
+	actions := map[string]string{
+		"resync":    common.RECONCILE_REQUESTED_AT_ANNOTATION,
+		"pause":     common.PAUSED_ANNOTATION,
+		"resume":    common.PAUSED_ANNOTATION,
+		"restart":   common.RESTART_REQUESTED_AT_ANNOTATION,
+		"run-now":   common.RUN_NOW_REQUESTED_AT_ANNOTATION,
+		"suspend":   common.SUSPEND_ANNOTATION,
+		"unsuspend": common.SUSPEND_ANNOTATION,
+	}
+	for action, annotation := range actions {
+		L := lua.NewState()
+		L.SetGlobal("obj", toLua(L, newFixture(common.KIND_WORKLOAD, 1).Object))
+		if err := L.DoString(readScript(action + ".lua")); err != nil {
+			t.Fatalf("running %s.lua: %v", action, err)
+		}
+		metadata := L.Get(-1).(*lua.LTable).RawGetString("metadata").(*lua.LTable)
+		value := metadata.RawGetString("annotations").(*lua.LTable).RawGetString(annotation)
+		if value == lua.LNil || value.String() == "" {
+			t.Errorf("%s did not set %s", action, annotation)
+		}
+		L.Close()
+	}
+}
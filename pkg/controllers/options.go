@@ -0,0 +1,334 @@
+package controllers
+
+import (
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Options configures the operator. The zero value of every field falls back to the default the standalone operator
+// uses, so embedding programs only need to set what they want to change.
+type Options struct {
+	// APIURL is the base URL of the Control Plane API. Defaults to https://api.cpln.io.
+	APIURL string
+	// WorkloadStatusURL is the websocket endpoint workload deployment updates are streamed from.
+	// Defaults to wss://workload-status.cpln.io/register.
+	WorkloadStatusURL string
+	// ChangeFeedURL is the websocket endpoint Control Plane resource changes are streamed from. When set, a CR is pulled
+	// as soon as its resource changes, instead of at the next poll.
+	ChangeFeedURL string
+	// CRDDirectory holds the cpln.io CRD manifests. A controller is built for each kind found there.
+	// Defaults to chart/templates/crd.
+	CRDDirectory string
+	// Kinds limits the kinds that get a controller. Every kind in CRDDirectory is managed when empty.
+	Kinds []string
+	// Namespaces limits the operator to resources in these namespaces. All namespaces are managed when empty.
+	Namespaces []string
+	// ExcludedNamespaces are never managed, even if listed in Namespaces
+	ExcludedNamespaces []string
+	// WatchNamespaces limits the manager cache to these namespaces, so the operator needs no access to the others. All
+	// namespaces are watched when empty. The manager must be created with CacheOptions for this to take effect.
+	WatchNamespaces []string
+	// OperatorClass is the class of this operator instance. Only CRs and Secrets whose cpln.io/operator-class label
+	// matches it are managed, unlabelled ones belonging to the default class. Defaults to common.DEFAULT_OPERATOR_CLASS.
+	OperatorClass string
+	// Credentials provides the Control Plane token of each org. Defaults to the token key of the Secret named after the
+	// org in the controlplane namespace.
+	Credentials cpln.CredentialProvider
+	// HTTPClient is used for every Control Plane API call. Defaults to a new http.Client.
+	HTTPClient *http.Client
+	// ReconcileInterval is how often resources are pulled from Control Plane. Defaults to 30 seconds.
+	ReconcileInterval time.Duration
+	// DisableBulkPolling pulls each resource from Control Plane every ReconcileInterval. By default, the resources of a
+	// kind are listed once per org and gvc, and only those that changed are pulled.
+	DisableBulkPolling bool
+	// RealtimeReconnectDelay is how long a realtime sync waits before reconnecting. Defaults to 5 seconds.
+	RealtimeReconnectDelay time.Duration
+	// WorkloadStatusMode is either common.STATUS_MODE_CHILDREN (the default) or common.STATUS_MODE_SUMMARY
+	WorkloadStatusMode string
+	// MaxConcurrentReconciles is how many resources of a kind are reconciled at once. Defaults to 1.
+	MaxConcurrentReconciles int
+	// MaxConcurrentReconcilesPerKind overrides MaxConcurrentReconciles for individual kinds
+	MaxConcurrentReconcilesPerKind map[string]int
+	// Sharding splits the CRs between the replicas of the operator, each reconciling the CRs of its part of a
+	// consistent-hash ring. Leader election must be disabled, since every replica takes part.
+	Sharding bool
+	// Namespace is the namespace the operator runs in, which holds the org token Secrets and the shard Leases. Defaults
+	// to controlplane.
+	Namespace string
+	// ShardIdentity names this replica on the ring. Defaults to the hostname, which is the pod name in Kubernetes.
+	ShardIdentity string
+	// ShardLeaseDuration is how long a replica keeps its share of the CRs without renewing its Lease. Defaults to 15
+	// seconds.
+	ShardLeaseDuration time.Duration
+	// Extensions customize the handling of individual kinds. They are registered on top of DefaultExtensions.
+	Extensions Extensions
+	// Maintenance pauses syncing of every resource, as if each had the cpln.io/paused annotation
+	Maintenance bool
+	// UnmanagedFields maps a kind to the JSON pointers of its fields that are managed in Control Plane only, on top of
+	// those of its KindExtension
+	UnmanagedFields map[string][]string
+	// DeletionPolicyPerKind sets what deleting the CRs of a kind does in Control Plane: "delete" deletes the resource,
+	// "confirm" deletes it once the CR is annotated with cpln.io/confirm-delete: <name>, and "never" leaves it there.
+	// It's merged over the defaults, which never delete orgs and require confirmation for gvcs and mk8s clusters.
+	DeletionPolicyPerKind map[string]string
+	// RemoteDeletionPolicy is what happens to a CR whose resource was deleted in Control Plane: "delete" deletes the CR,
+	// "recreate" pushes it again, and "keep" keeps it with a RemoteDeleted condition. Defaults to "delete".
+	RemoteDeletionPolicy string
+	// RemoteDeletionPolicyPerKind overrides RemoteDeletionPolicy for individual kinds. The
+	// cpln.io/remote-deletion-policy annotation overrides both per CR.
+	RemoteDeletionPolicyPerKind map[string]string
+	// ConflictPolicy is how a push handles changes made in Control Plane since the last sync: "abort" holds it with a
+	// Conflict condition, "merge" merges the changes that don't overlap with those of the CR, and "overwrite" pushes
+	// over them. Defaults to "overwrite". The cpln.io/conflict-policy annotation overrides it per CR.
+	ConflictPolicy string
+	// Shadow runs the operator without writing to Control Plane or to the spec of any Kubernetes resource. Pushes are
+	// dry runs, deletes are skipped, and every skipped action is logged, counted in the
+	// cpln_operator_shadow_actions_total metric and recorded in status.operator.wouldHaveDone.
+	Shadow bool
+	// PlanOnly records the changes a push would make in the status of every CR instead of pushing, as if each had the
+	// cpln.io/plan-only annotation
+	PlanOnly bool
+	// DisableControllers skips the controllers, leaving only the webhook handlers
+	DisableControllers bool
+	// DisableWebhook skips registering the webhook handlers with the manager's webhook server
+	DisableWebhook bool
+}
+
+// OptionsFromEnv reads the options from the environment variables documented in chart/values.yaml
+func OptionsFromEnv() Options {
+	return Options{
+		APIURL:                         common.GetEnvStr("CPLN_API_URL", ""),
+		WorkloadStatusURL:              common.GetEnvStr("CPLN_WORKLOAD_STATUS_URL", ""),
+		ChangeFeedURL:                  common.GetEnvStr("CPLN_CHANGE_FEED_URL", ""),
+		Kinds:                          common.GetEnvSlice[string]("MANAGE_KINDS", nil),
+		Namespaces:                     common.GetEnvSlice[string]("MANAGE_NAMESPACES", nil),
+		ExcludedNamespaces:             common.GetEnvSlice[string]("EXCLUDE_NAMESPACES", nil),
+		WatchNamespaces:                common.GetEnvSlice[string]("WATCH_NAMESPACES", nil),
+		OperatorClass:                  common.GetEnvStr("OPERATOR_CLASS", ""),
+		ReconcileInterval:              time.Second * time.Duration(common.GetEnvInt("RECONCILE_INTERVAL_SECONDS", 0)),
+		DisableBulkPolling:             !common.GetEnvBool("BULK_POLLING_ENABLED", true),
+		MaxConcurrentReconciles:        common.GetEnvInt("MAX_CONCURRENT_RECONCILES", 0),
+		MaxConcurrentReconcilesPerKind: parseKindCounts(common.GetEnvSlice[string]("MAX_CONCURRENT_RECONCILES_PER_KIND", nil)),
+		WorkloadStatusMode:             common.GetEnvStr("WORKLOAD_STATUS_MODE", ""),
+		Maintenance:                    common.GetEnvBool("MAINTENANCE_MODE", false),
+		PlanOnly:                       common.GetEnvBool("PLAN_ONLY", false),
+		Shadow:                         common.GetEnvBool("SHADOW_MODE", false),
+		ConflictPolicy:                 common.GetEnvStr("CONFLICT_POLICY", ""),
+		UnmanagedFields:                parseKindPointers(common.GetEnvSlice[string]("UNMANAGED_FIELDS", nil)),
+		DeletionPolicyPerKind:          parseKindValues(common.GetEnvSlice[string]("DELETION_POLICY_PER_KIND", nil)),
+		RemoteDeletionPolicy:           common.GetEnvStr("REMOTE_DELETION_POLICY", ""),
+		RemoteDeletionPolicyPerKind:    parseKindValues(common.GetEnvSlice[string]("REMOTE_DELETION_POLICY_PER_KIND", nil)),
+		Sharding:                       common.GetEnvBool("SHARDING_ENABLED", false),
+		ShardIdentity:                  common.GetEnvStr("POD_NAME", ""),
+		Namespace:                      common.GetEnvStr("POD_NAMESPACE", ""),
+		ShardLeaseDuration:             time.Second * time.Duration(common.GetEnvInt("SHARD_LEASE_DURATION_SECONDS", 0)),
+		DisableControllers:             !common.GetEnvBool("CONTROLLER_ENABLED", true),
+	}
+}
+
+func (o Options) withDefaults() Options {
+	if o.APIURL == "" {
+		o.APIURL = "https://api.cpln.io"
+	}
+	if o.WorkloadStatusURL == "" {
+		o.WorkloadStatusURL = "wss://workload-status.cpln.io/register"
+	}
+	if o.CRDDirectory == "" {
+		o.CRDDirectory = "chart/templates/crd"
+	}
+	if o.HTTPClient == nil {
+		o.HTTPClient = &http.Client{}
+	}
+	if o.ReconcileInterval <= 0 {
+		o.ReconcileInterval = 30 * time.Second
+	}
+	if o.ShardIdentity == "" {
+		o.ShardIdentity, _ = os.Hostname()
+	}
+	if o.ShardLeaseDuration <= 0 {
+		o.ShardLeaseDuration = 15 * time.Second
+	}
+	if o.RealtimeReconnectDelay <= 0 {
+		o.RealtimeReconnectDelay = 5 * time.Second
+	}
+	extensions := DefaultExtensions()
+	for kind, ext := range o.Extensions {
+		extensions.Register(kind, ext)
+	}
+	o.Extensions = extensions
+	if o.MaxConcurrentReconciles <= 0 {
+		o.MaxConcurrentReconciles = 1
+	}
+	o.OperatorClass = o.operatorClass()
+	if o.RemoteDeletionPolicy == "" {
+		o.RemoteDeletionPolicy = common.REMOTE_DELETION_POLICY_DELETE
+	}
+	if o.ConflictPolicy == "" {
+		o.ConflictPolicy = common.CONFLICT_POLICY_OVERWRITE
+	}
+	if o.WorkloadStatusMode == "" {
+		o.WorkloadStatusMode = common.STATUS_MODE_CHILDREN
+	}
+	return o
+}
+
+// ManagesNamespace reports whether resources in the namespace are managed under these options
+func (o Options) ManagesNamespace(namespace string) bool {
+	if slices.Contains(o.ExcludedNamespaces, namespace) {
+		return false
+	}
+	if len(o.WatchNamespaces) > 0 && !slices.Contains(o.WatchNamespaces, namespace) {
+		return false
+	}
+	return len(o.Namespaces) == 0 || slices.Contains(o.Namespaces, namespace)
+}
+
+// CacheOptions returns the cache options of the manager the operator runs in. Only the Secrets the operator manages
+// are cached: those of its class, plus the Secrets holding the org tokens in the controller namespace, which every
+// class shares. With WatchNamespaces set, the cache is limited to those namespaces and the controller namespace.
+func (o Options) CacheOptions() cache.Options {
+	managed := map[string]string{
+		"app.kubernetes.io/managed-by": "cpln-operator",
+	}
+	managedByClass := map[string]string{
+		"app.kubernetes.io/managed-by": "cpln-operator",
+	}
+	//A selector can't match a missing label, so the default class caches the Secrets of every class and leaves the
+	//others to its predicates
+	if o.operatorClass() != common.DEFAULT_OPERATOR_CLASS {
+		managedByClass[common.OPERATOR_CLASS_LABEL] = o.operatorClass()
+	}
+
+	opts := cache.Options{}
+	secretNamespaces := map[string]cache.Config{
+		o.namespace(): {LabelSelector: labels.SelectorFromSet(managed)},
+	}
+	if len(o.WatchNamespaces) > 0 {
+		opts.DefaultNamespaces = map[string]cache.Config{}
+		for _, namespace := range o.WatchNamespaces {
+			opts.DefaultNamespaces[namespace] = cache.Config{}
+			secretNamespaces[namespace] = cache.Config{LabelSelector: labels.SelectorFromSet(managedByClass)}
+		}
+	} else {
+		secretNamespaces[cache.AllNamespaces] = cache.Config{LabelSelector: labels.SelectorFromSet(managedByClass)}
+	}
+	opts.ByObject = map[client.Object]cache.ByObject{
+		&corev1.Secret{}: {Namespaces: secretNamespaces},
+	}
+	return opts
+}
+
+// ManagesClass reports whether resources with these labels belong to the class of this operator
+func (o Options) ManagesClass(labels map[string]string) bool {
+	class := labels[common.OPERATOR_CLASS_LABEL]
+	if class == "" {
+		class = common.DEFAULT_OPERATOR_CLASS
+	}
+	return class == o.operatorClass()
+}
+
+// Manages reports whether the object is managed under these options
+func (o Options) Manages(obj client.Object) bool {
+	return o.ManagesNamespace(obj.GetNamespace()) && o.ManagesClass(obj.GetLabels())
+}
+
+func (o Options) namespace() string {
+	if o.Namespace == "" {
+		return common.CONTROLLER_NAMESPACE
+	}
+	return o.Namespace
+}
+
+func (o Options) operatorClass() string {
+	if o.OperatorClass == "" {
+		return common.DEFAULT_OPERATOR_CLASS
+	}
+	return o.OperatorClass
+}
+
+// LeaderElectionID names the Lease of the leader among the replicas of the class, in the namespace of the operator
+func (o Options) LeaderElectionID() string {
+	return "cpln-operator-leader-" + o.operatorClass()
+}
+
+// defaultDeletionPolicies protect the kinds whose deletion takes the most down with it
+var defaultDeletionPolicies = map[string]string{
+	common.KIND_ORG:  common.DELETION_POLICY_NEVER,
+	common.KIND_GVC:  common.DELETION_POLICY_CONFIRM,
+	common.KIND_MK8S: common.DELETION_POLICY_CONFIRM,
+}
+
+// DeletionPolicy returns what deleting a CR of the kind does in Control Plane
+func (o Options) DeletionPolicy(kind string) string {
+	if policy := o.DeletionPolicyPerKind[kind]; policy != "" {
+		return policy
+	}
+	if policy := defaultDeletionPolicies[kind]; policy != "" {
+		return policy
+	}
+	return common.DELETION_POLICY_DELETE
+}
+
+// maxConcurrentReconciles returns how many resources of the kind are reconciled at once
+func (o Options) maxConcurrentReconciles(kind string) int {
+	if n := o.MaxConcurrentReconcilesPerKind[kind]; n > 0 {
+		return n
+	}
+	return o.MaxConcurrentReconciles
+}
+
+// parseKindCounts parses kind=count pairs, skipping malformed ones
+func parseKindCounts(pairs []string) map[string]int {
+	counts := map[string]int{}
+	for _, pair := range pairs {
+		kind, count, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(count))
+		if err != nil {
+			continue
+		}
+		counts[strings.TrimSpace(kind)] = n
+	}
+	return counts
+}
+
+// parseKindPointers parses kind=pointer pairs, e.g. workload=/spec/defaultOptions/suspend, into the pointers of each
+// kind. A kind can be given several times.
+func parseKindPointers(pairs []string) map[string][]string {
+	pointers := map[string][]string{}
+	for _, pair := range pairs {
+		kind, pointer, ok := strings.Cut(pair, "=")
+		if !ok || !strings.HasPrefix(strings.TrimSpace(pointer), "/") {
+			continue
+		}
+		kind = strings.TrimSpace(kind)
+		pointers[kind] = append(pointers[kind], strings.TrimSpace(pointer))
+	}
+	return pointers
+}
+
+// parseKindValues parses kind=value pairs, e.g. workload=keep
+func parseKindValues(pairs []string) map[string]string {
+	values := map[string]string{}
+	for _, pair := range pairs {
+		kind, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		values[strings.TrimSpace(kind)] = strings.TrimSpace(value)
+	}
+	return values
+}
@@ -0,0 +1,85 @@
+package controllers_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/controllers"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+)
+
+func TestWatchNamespaces(t *testing.T) {
+	opts := controllers.Options{
+		WatchNamespaces:    []string{"team-a", "team-b"},
+		Namespaces:         []string{"team-a", "team-c"},
+		ExcludedNamespaces: []string{"team-b"},
+	}
+
+	// Scenario: A namespace is only managed when it is watched, listed and not excluded.
+	for namespace, want := range map[string]bool{"team-a": true, "team-b": false, "team-c": false, "other": false} {
+		if got := opts.ManagesNamespace(namespace); got != want {
+			t.Errorf("ManagesNamespace(%q) = %v, want %v", namespace, got, want)
+		}
+	}
+
+	// Scenario: The cache only holds the watched namespaces, plus the controller namespace for Secrets.
+	cacheOpts := opts.CacheOptions()
+	if len(cacheOpts.DefaultNamespaces) != 2 {
+		t.Errorf("DefaultNamespaces = %v, want team-a and team-b", cacheOpts.DefaultNamespaces)
+	}
+	for obj, byObject := range cacheOpts.ByObject {
+		if _, ok := obj.(*corev1.Secret); !ok {
+			continue
+		}
+		if _, ok := byObject.Namespaces[common.CONTROLLER_NAMESPACE]; !ok || len(byObject.Namespaces) != 3 {
+			t.Errorf("Secret namespaces = %v, want the watched and the controller namespace", byObject.Namespaces)
+		}
+	}
+
+	// Scenario: Without WatchNamespaces, every namespace is cached.
+	if cacheOpts := (controllers.Options{}).CacheOptions(); cacheOpts.DefaultNamespaces != nil {
+		t.Errorf("DefaultNamespaces = %v, want all namespaces", cacheOpts.DefaultNamespaces)
+	}
+}
+
+func TestOperatorClass(t *testing.T) {
+	labelled := func(class string) *corev1.Secret {
+		s := &corev1.Secret{}
+		s.SetNamespace("team-a")
+		if class != "" {
+			s.SetLabels(map[string]string{common.OPERATOR_CLASS_LABEL: class})
+		}
+		return s
+	}
+
+	// Scenario: The default class manages unlabelled resources and those labelled with the default class.
+	opts := controllers.Options{}
+	for class, want := range map[string]bool{"": true, common.DEFAULT_OPERATOR_CLASS: true, "canary": false} {
+		if got := opts.Manages(labelled(class)); got != want {
+			t.Errorf("default class: Manages(%q) = %v, want %v", class, got, want)
+		}
+	}
+
+	// Scenario: Any other class only manages resources labelled with it.
+	opts = controllers.Options{OperatorClass: "canary"}
+	for class, want := range map[string]bool{"": false, common.DEFAULT_OPERATOR_CLASS: false, "canary": true} {
+		if got := opts.Manages(labelled(class)); got != want {
+			t.Errorf("canary class: Manages(%q) = %v, want %v", class, got, want)
+		}
+	}
+
+	// Scenario: Its Secret cache only selects Secrets of the class, except for the shared org tokens.
+	for obj, byObject := range opts.CacheOptions().ByObject {
+		if _, ok := obj.(*corev1.Secret); !ok {
+			continue
+		}
+		if selector := byObject.Namespaces[cache.AllNamespaces].LabelSelector.String(); !strings.Contains(selector, common.OPERATOR_CLASS_LABEL+"=canary") {
+			t.Errorf("Secret selector = %s, want the canary class", selector)
+		}
+		if selector := byObject.Namespaces[common.CONTROLLER_NAMESPACE].LabelSelector.String(); strings.Contains(selector, common.OPERATOR_CLASS_LABEL) {
+			t.Errorf("controller namespace Secret selector = %s, want every class", selector)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package controllers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// noChanges is the plan of a CR that matches Control Plane
+const noChanges = "No changes"
+
+// planOnly reports whether changes to the CR are planned instead of pushed
+func (r *controller) planOnly(cr *unstructured.Unstructured) bool {
+	return r.opts.PlanOnly || cr.GetAnnotations()[common.PLAN_ONLY_ANNOTATION] == "true"
+}
+
+// plan records in status.operator.plan what pushing the CR would change in Control Plane, by comparing the resource
+// with the result of a dry run. Nothing is pushed, so the plan is refreshed on every reconcile until plan mode is
+// turned off.
+func (r *controller) plan(ctx cpln.Context, log logr.Logger, cr *unstructured.Unstructured) (ctrl.Result, error) {
+	log.Info("Plan mode is on, computing the changes a push would make")
+	p, err := r.computePlan(ctx, log, cr)
+	if err != nil {
+		return zeroResult, err
+	}
+	before := cr.DeepCopy()
+	recordPlan(cr, p)
+	log.Info("Planned changes", "planHash", operatorStatus(cr)["planHash"])
+	if err := r.writeChangedStatus(ctx, log, before, cr); err != nil {
+		return zeroResult, err
+	}
+	return r.defaultResult(), nil
+}
+
+// computePlan renders the changes pushing the CR would make, by comparing the Control Plane resource with the result
+// of a dry run
+func (r *controller) computePlan(ctx cpln.Context, log logr.Logger, cr *unstructured.Unstructured) (string, error) {
+	current, err := r.liveResource(ctx, log, cr)
+	if err != nil {
+		return "", err
+	}
+
+	cplnResourceAfterDryRun, err := r.cplnConnector.Put(ctx, r.withActions(r.preserveUnmanaged(cr, current)), true)
+	if err != nil {
+		log.Error(err, "Error during cpln dry run")
+		return "", err
+	}
+	planned := map[string]any{}
+	if err := json.Unmarshal([]byte(cplnResourceAfterDryRun), &planned); err != nil {
+		log.Error(err, fmt.Sprintf("could not unmarshal response from Control Plane: %s", cplnResourceAfterDryRun))
+		return "", err
+	}
+	//The status of a Secret is readable by more users than its data
+	return renderPlan(current, planned, r.gvk == common.NativeSecretGVK), nil
+}
+
+// recordPlan stores the plan of the current generation in the status. Computing it made the same calls a push would,
+// so the errors of earlier attempts are cleared.
+func recordPlan(cr *unstructured.Unstructured, plan string) {
+	o := operatorStatus(cr)
+	o["plan"] = plan
+	o["planHash"] = planHash(generation(cr), plan)
+	delete(o, "validationError")
+	resetRetries(cr)
+}
+
+// writeChangedStatus writes the status of the CR, unless it is the same as before
+func (r *controller) writeChangedStatus(ctx cpln.Context, log logr.Logger, before, cr *unstructured.Unstructured) error {
+	if reflect.DeepEqual(before.Object["status"], cr.Object["status"]) {
+		return nil
+	}
+	if err := r.k8sConnector.WriteStatus(ctx, cr); err != nil {
+		log.Error(err, "Failed to update resource status")
+		return err
+	}
+	return nil
+}
+
+// renderPlan lists the changes between the current Control Plane resource and the planned one, one per line, as
+// "+ path: value" for added fields, "- path: value" for removed ones and "~ path: old -> new" for changed ones. The
+// fields left out of the comparison with the CR are left out of the plan too. Redacted plans list the changed paths
+// without their values.
+func renderPlan(current, planned map[string]any, redacted bool) string {
+	current, planned = withoutIgnoredFields(current), withoutIgnoredFields(planned)
+	var lines []string
+	planner{lines: &lines, redacted: redacted}.changes("", current, planned)
+	if len(lines) == 0 {
+		return noChanges
+	}
+	return strings.Join(lines, "\n")
+}
+
+func withoutIgnoredFields(resource map[string]any) map[string]any {
+	out := make(map[string]any, len(resource))
+	for k, v := range resource {
+		if !slices.Contains(ignoredFields, k) {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+type planner struct {
+	lines    *[]string
+	redacted bool
+}
+
+// changes appends the changes from a to b under path. Maps are compared key by key and lists of the same length item
+// by item, so a change to one container shows up as that container's field, not the whole list.
+func (p planner) changes(path string, a, b any) {
+	if reflect.DeepEqual(a, b) {
+		return
+	}
+	switch {
+	case a == nil:
+		*p.lines = append(*p.lines, fmt.Sprintf("+ %s: %s", path, p.value(b)))
+		return
+	case b == nil:
+		*p.lines = append(*p.lines, fmt.Sprintf("- %s: %s", path, p.value(a)))
+		return
+	}
+	aMap, aIsMap := a.(map[string]any)
+	bMap, bIsMap := b.(map[string]any)
+	if aIsMap && bIsMap {
+		var keys []string
+		for k := range aMap {
+			keys = append(keys, k)
+		}
+		for k := range bMap {
+			if _, ok := aMap[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			field := k
+			if path != "" {
+				field = path + "." + k
+			}
+			p.changes(field, aMap[k], bMap[k])
+		}
+		return
+	}
+	aList, aIsList := a.([]any)
+	bList, bIsList := b.([]any)
+	if aIsList && bIsList && len(aList) == len(bList) {
+		for i := range aList {
+			p.changes(fmt.Sprintf("%s[%d]", path, i), aList[i], bList[i])
+		}
+		return
+	}
+	*p.lines = append(*p.lines, fmt.Sprintf("~ %s: %s -> %s", path, p.value(a), p.value(b)))
+}
+
+func (p planner) value(v any) string {
+	if p.redacted {
+		return "<redacted>"
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// planHash identifies the plan of a generation, so that it can be referred to without repeating it. The same changes
+// planned for another generation get another hash.
+func planHash(generation int64, plan string) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d\n%s", generation, plan)))
+	return hex.EncodeToString(sum[:])
+}
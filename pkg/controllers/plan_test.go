@@ -0,0 +1,56 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/controlplane-com/k8s-operator/pkg/controllers"
+)
+
+func TestRenderPlan(t *testing.T) {
+	parse := func(s string) map[string]any {
+		m := map[string]any{}
+		if err := json.Unmarshal([]byte(s), &m); err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+	current := parse(`{
+		"name": "api", "version": 7, "description": "old", "tags": {"team": "a"},
+		"spec": {"containers": [{"name": "main", "image": "api:1", "cpu": "50m"}], "firewallConfig": {"external": {}}},
+		"status": {"endpoint": "https://api"}
+	}`)
+	planned := parse(`{
+		"name": "api", "version": 8, "description": "old", "tags": {"team": "a", "env": "prod"},
+		"spec": {"containers": [{"name": "main", "image": "api:2"}], "defaultOptions": {"capacityAI": false}},
+		"status": {}
+	}`)
+
+	// Scenario: Each changed field is listed on its own line, sorted by path. Server fields and the status are left out.
+	want := `- spec.containers[0].cpu: "50m"
+~ spec.containers[0].image: "api:1" -> "api:2"
++ spec.defaultOptions: {"capacityAI":false}
+- spec.firewallConfig: {"external":{}}
++ tags.env: "prod"`
+	got := controllers.RenderPlan(current, planned, false)
+	if got != want {
+		t.Errorf("plan =\n%s\nwant\n%s", got, want)
+	}
+
+	// Scenario: A resource that matches Control Plane has nothing to plan.
+	if got := controllers.RenderPlan(current, current, false); got != "No changes" {
+		t.Errorf("plan of an unchanged resource = %q", got)
+	}
+
+	// Scenario: Lists of a different length are replaced as a whole.
+	got = controllers.RenderPlan(parse(`{"spec": {"args": ["a"]}}`), parse(`{"spec": {"args": ["a", "b"]}}`), false)
+	if want := `~ spec.args: ["a"] -> ["a","b"]`; got != want {
+		t.Errorf("plan = %q, want %q", got, want)
+	}
+
+	// Scenario: Redacted plans list the changed paths without their values.
+	got = controllers.RenderPlan(parse(`{"data": {"password": "old"}}`), parse(`{"data": {"password": "new"}}`), true)
+	if want := `~ data.password: <redacted> -> <redacted>`; got != want {
+		t.Errorf("plan = %q, want %q", got, want)
+	}
+}
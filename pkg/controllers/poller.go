@@ -0,0 +1,208 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	"github.com/evanphx/json-patch/v5"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// poller replaces the periodic pull of each CR with one list call per org, gvc and kind. CRs whose Control Plane
+// resource moved since their last sync are sent to the controller, which pulls them as usual.
+type poller struct {
+	reader     client.Reader
+	controller *controller
+	events     chan<- event.GenericEvent
+}
+
+type pollScope struct {
+	org string
+	gvc string
+}
+
+func newPoller(reader client.Reader, r *controller, events chan<- event.GenericEvent) *poller {
+	return &poller{
+		reader:     reader,
+		controller: r,
+		events:     events,
+	}
+}
+
+// NeedLeaderElection is false while sharding, since every replica polls the CRs it owns
+func (p *poller) NeedLeaderElection() bool {
+	return !p.controller.opts.Sharding
+}
+
+// Start polls every ReconcileInterval until the context is done
+func (p *poller) Start(ctx context.Context) error {
+	l := log.FromContext(ctx).WithValues("kind", p.controller.gvk.Kind)
+	ticker := time.NewTicker(p.controller.opts.ReconcileInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		moved, err := p.poll(ctx, l)
+		if err != nil {
+			l.Error(err, "Error listing resources to poll")
+			continue
+		}
+		for _, cr := range moved {
+			select {
+			case p.events <- event.GenericEvent{Object: cr}:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}
+}
+
+// poll returns the CRs that need to be pulled from Control Plane. A CR is returned when its resource moved, when it
+// is gone, or when its org or gvc can't be listed.
+func (p *poller) poll(ctx context.Context, l logr.Logger) ([]*unstructured.Unstructured, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(p.controller.gvk.GroupVersion().WithKind(p.controller.gvk.Kind + "List"))
+	if err := p.reader.List(ctx, list); err != nil {
+		return nil, err
+	}
+
+	scopes := map[pollScope][]*unstructured.Unstructured{}
+	for i := range list.Items {
+		cr := &list.Items[i]
+		if !p.controller.opts.Manages(cr) || !p.controller.shards.owns(cr) || !pulled(cr) {
+			continue
+		}
+		org, _ := cr.Object["org"].(string)
+		gvc, _ := cr.Object["gvc"].(string)
+		scope := pollScope{org: org, gvc: gvc}
+		scopes[scope] = append(scopes[scope], cr)
+	}
+
+	var moved []*unstructured.Unstructured
+	for scope, crs := range scopes {
+		items, err := p.list(ctx, scope, crs[0])
+		if err != nil {
+			l.Error(err, "Error listing resources from Control Plane, pulling each of them instead", "org", scope.org, "gvc", scope.gvc)
+			moved = append(moved, crs...)
+			continue
+		}
+		for _, cr := range crs {
+			item, ok := items[cpln.Name(cr)]
+			if !ok || remoteMoved(cr, item) {
+				moved = append(moved, cr)
+			}
+		}
+	}
+	return moved, nil
+}
+
+// list returns the Control Plane resources of the scope by name
+func (p *poller) list(ctx context.Context, scope pollScope, cr *unstructured.Unstructured) (map[string]map[string]any, error) {
+	cplnContext, err := p.controller.cplnConnector.Context(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+	url := cpln.ListUrl(p.controller.opts.APIURL, scope.org, scope.gvc, p.controller.gvk.Kind)
+	items, err := cpln.List(cplnContext, p.controller.HttpClient, url)
+	if err != nil {
+		return nil, err
+	}
+	byName := map[string]map[string]any{}
+	for _, item := range items {
+		name, _ := item["name"].(string)
+		byName[name] = item
+	}
+	return byName, nil
+}
+
+// pulled reports whether the CR is kept up to date by pulling from Control Plane. CRs with changes to push, or that are
+// being deleted, are requeued by their own controller.
+func pulled(cr *unstructured.Unstructured) bool {
+	if cr.GetDeletionTimestamp() != nil {
+		return false
+	}
+	lastSynced, _, _ := unstructured.NestedInt64(cr.Object, "status", "operator", "lastSyncedGeneration")
+	return lastSynced == generation(cr)
+}
+
+// remoteMoved reports whether the version or the status of the Control Plane resource changed since the CR was last
+// synced. Only the status fields Control Plane returns are compared, since the operator keeps its own fields next to
+// them. The CR is not modified, so it may come straight from the cache.
+func remoteMoved(cr *unstructured.Unstructured, item map[string]any) bool {
+	version, ok := remoteVersion(cr)
+	if !ok {
+		return true
+	}
+	known := map[string]any{"version": version}
+	latest := map[string]any{"version": item["version"]}
+	if remoteStatus, ok := item["status"].(map[string]any); ok {
+		st, _ := cr.Object["status"].(map[string]any)
+		knownStatus := map[string]any{}
+		for k := range remoteStatus {
+			if v, ok := st[k]; ok {
+				knownStatus[k] = v
+			}
+		}
+		known["status"] = knownStatus
+		latest["status"] = remoteStatus
+	}
+
+	knownJson, err := json.Marshal(known)
+	if err != nil {
+		return true
+	}
+	latestJson, err := json.Marshal(latest)
+	if err != nil {
+		return true
+	}
+	patch, err := diff(knownJson, latestJson)
+	return err != nil || len(patch) > 0
+}
+
+// diff returns the top-level fields of the merge patch from original to modified
+func diff(original, modified []byte) (map[string]any, error) {
+	patch, err := jsonpatch.CreateMergePatch(original, modified)
+	if err != nil {
+		return nil, err
+	}
+	patchMap := map[string]any{}
+	if err = json.Unmarshal(patch, &patchMap); err != nil {
+		return nil, err
+	}
+	return patchMap, nil
+}
+
+// remoteVersion returns the Control Plane version recorded by the last sync
+func remoteVersion(cr *unstructured.Unstructured) (int64, bool) {
+	v, ok, _ := unstructured.NestedFieldNoCopy(cr.Object, "status", "operator", "remoteVersion")
+	if !ok {
+		return 0, false
+	}
+	switch version := v.(type) {
+	case int64:
+		return version, true
+	case float64:
+		return int64(version), true
+	default:
+		return 0, false
+	}
+}
+
+// recordRemoteVersion stores the version of the Control Plane resource the CR was synced with
+func recordRemoteVersion(cr *unstructured.Unstructured, resource map[string]any) {
+	o := operatorStatus(cr)
+	if version, ok := resource["version"].(float64); ok {
+		o["remoteVersion"] = int64(version)
+	} else {
+		delete(o, "remoteVersion")
+	}
+}
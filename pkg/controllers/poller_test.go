@@ -0,0 +1,113 @@
+package controllers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/controllers"
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// listReader serves a fixed list of CRs, standing in for the manager's cache
+type listReader struct {
+	client.Reader
+	items []unstructured.Unstructured
+}
+
+func (r listReader) List(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+	list.(*unstructured.UnstructuredList).Items = r.items
+	return nil
+}
+
+func TestPollerEnqueuesMovedResources(t *testing.T) {
+	pages := map[string]map[string]any{
+		"/org/acme/gvc/main/workload": {
+			"items": []map[string]any{
+				{"name": "unchanged", "version": 3, "status": map[string]any{"endpoint": "https://unchanged"}},
+				{"name": "new-version", "version": 5},
+			},
+			"links": []map[string]any{{"rel": "next", "href": "/org/acme/gvc/main/workload?cursor=2"}},
+		},
+		"/org/acme/gvc/main/workload?cursor=2": {
+			"items": []map[string]any{
+				{"name": "new-status", "version": 1, "status": map[string]any{"endpoint": "https://new"}},
+				{"name": "pushing", "version": 9},
+				{"name": "unrecorded", "version": 1},
+			},
+		},
+	}
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.URL.RequestURI())
+		page, ok := pages[r.URL.RequestURI()]
+		if !ok {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	newCR := func(name, org string, remoteVersion int64, status map[string]any) unstructured.Unstructured {
+		cr := unstructured.Unstructured{Object: map[string]any{"org": org, "gvc": "main"}}
+		cr.SetName(name)
+		cr.SetNamespace("default")
+		cr.SetGeneration(2)
+		if status == nil {
+			status = map[string]any{}
+		}
+		operator := map[string]any{"lastSyncedGeneration": int64(2)}
+		if remoteVersion > 0 {
+			operator["remoteVersion"] = remoteVersion
+		}
+		status["operator"] = operator
+		status["phase"] = "Ready"
+		cr.Object["status"] = status
+		return cr
+	}
+	pushing := newCR("pushing", "acme", 9, nil)
+	pushing.SetGeneration(3)
+	reader := listReader{items: []unstructured.Unstructured{
+		newCR("unchanged", "acme", 3, map[string]any{"endpoint": "https://unchanged", "health": map[string]any{"ready": true}}),
+		newCR("new-version", "acme", 4, nil),
+		newCR("new-status", "acme", 1, map[string]any{"endpoint": "https://old"}),
+		newCR("gone", "acme", 2, nil),
+		pushing,
+		newCR("unrecorded", "acme", 0, nil),
+		newCR("unlisted", "broken", 1, nil),
+	}}
+
+	moved, err := controllers.PollMoved(context.Background(), reader, controllers.Options{
+		APIURL: server.URL,
+		Credentials: cpln.CredentialProviderFunc(func(context.Context, string) (string, error) {
+			return "token", nil
+		}),
+	}, schema.GroupVersionKind{Group: common.API_GROUP, Version: common.API_REVISION, Kind: common.KIND_WORKLOAD})
+	if err != nil {
+		t.Fatalf("poll failed: %v", err)
+	}
+	slices.Sort(moved)
+
+	// Scenario: Only CRs whose resource moved, is gone, wasn't recorded yet or couldn't be listed are pulled. The CR
+	// with changes to push is left to its controller, and the status the operator keeps next to Control Plane's doesn't
+	// count as a change.
+	want := []string{"gone", "new-status", "new-version", "unlisted", "unrecorded"}
+	if !slices.Equal(moved, want) {
+		t.Errorf("moved = %v, want %v", moved, want)
+	}
+
+	// Scenario: Each org and gvc is listed once per poll, following the next links.
+	slices.Sort(calls)
+	wantCalls := []string{"/org/acme/gvc/main/workload", "/org/acme/gvc/main/workload?cursor=2", "/org/broken/gvc/main/workload"}
+	if !slices.Equal(calls, wantCalls) {
+		t.Errorf("calls = %v, want %v", calls, wantCalls)
+	}
+}
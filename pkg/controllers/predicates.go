@@ -1,29 +1,34 @@
 package controllers
 
 import (
-	"github.com/controlplane-com/k8s-operator/pkg/common"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
 
-func syncPredicate() predicate.Predicate {
+func syncPredicate(namespace string) predicate.Predicate {
 	return predicate.Funcs{
 		UpdateFunc: func(e event.UpdateEvent) bool {
-			return shouldSyncObject(e.ObjectNew)
+			return shouldSyncObject(e.ObjectNew, namespace)
 		},
 		CreateFunc: func(e event.CreateEvent) bool {
-			return shouldSyncObject(e.Object)
+			return shouldSyncObject(e.Object, namespace)
 		},
 		DeleteFunc: func(e event.DeleteEvent) bool {
-			return shouldSyncObject(e.Object)
+			return shouldSyncObject(e.Object, namespace)
 		},
 		GenericFunc: func(e event.GenericEvent) bool {
-			return shouldSyncObject(e.Object)
+			return shouldSyncObject(e.Object, namespace)
 		},
 	}
 }
 
-func shouldSyncObject(obj client.Object) bool {
-	return obj.GetNamespace() != common.CONTROLLER_NAMESPACE
+// shouldSyncObject skips the Secrets in the namespace of the operator, which hold its org tokens
+func shouldSyncObject(obj client.Object, namespace string) bool {
+	return obj.GetNamespace() != namespace
+}
+
+// managedPredicate filters out resources in namespaces the operator doesn't manage, or of another operator class
+func managedPredicate(opts Options) predicate.Predicate {
+	return predicate.NewPredicateFuncs(opts.Manages)
 }
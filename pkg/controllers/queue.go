@@ -0,0 +1,129 @@
+package controllers
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var queueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "cpln_operator_queue_depth",
+	Help: "Number of resources waiting to be reconciled, by controller and org",
+}, []string{"controller", "org"})
+
+func init() {
+	metrics.Registry.MustRegister(queueDepth)
+}
+
+// orgIndex remembers the org of each resource, so the work queue can tell which org a request belongs to
+type orgIndex struct {
+	orgs sync.Map
+}
+
+func (i *orgIndex) orgOf(req reconcile.Request) string {
+	org, _ := i.orgs.Load(req.NamespacedName)
+	s, _ := org.(string)
+	return s
+}
+
+// predicate records the org of every object before its request is queued
+func (i *orgIndex) predicate() predicate.Predicate {
+	record := func(obj client.Object) bool {
+		if u, ok := obj.(*unstructured.Unstructured); ok {
+			org, _ := u.Object["org"].(string)
+			i.orgs.Store(types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, org)
+		}
+		return true
+	}
+	return predicate.Funcs{
+		CreateFunc:  func(e event.CreateEvent) bool { return record(e.Object) },
+		UpdateFunc:  func(e event.UpdateEvent) bool { return record(e.ObjectNew) },
+		GenericFunc: func(e event.GenericEvent) bool { return record(e.Object) },
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			i.orgs.Delete(types.NamespacedName{Namespace: e.Object.GetNamespace(), Name: e.Object.GetName()})
+			return true
+		},
+	}
+}
+
+// fairQueue orders queued requests round-robin between orgs, so that one org with many resources can't starve the
+// others. It only decides the order: deduplication, rate limiting and delays are left to the client-go work queue
+// wrapped around it, which also serializes all calls.
+type fairQueue struct {
+	controller string
+	orgOf      func(reconcile.Request) string
+	queues     map[string][]reconcile.Request
+	// orgs lists the orgs with queued requests, in round-robin order
+	orgs []string
+	next int
+	len  int
+}
+
+func newFairQueue(controller string, orgOf func(reconcile.Request) string) *fairQueue {
+	return &fairQueue{
+		controller: controller,
+		orgOf:      orgOf,
+		queues:     map[string][]reconcile.Request{},
+	}
+}
+
+func (q *fairQueue) Touch(reconcile.Request) {}
+
+func (q *fairQueue) Push(item reconcile.Request) {
+	org := q.orgOf(item)
+	if len(q.queues[org]) == 0 {
+		q.orgs = append(q.orgs, org)
+	}
+	q.queues[org] = append(q.queues[org], item)
+	q.len++
+	queueDepth.WithLabelValues(q.controller, org).Inc()
+}
+
+func (q *fairQueue) Len() int {
+	return q.len
+}
+
+func (q *fairQueue) Pop() reconcile.Request {
+	if q.next >= len(q.orgs) {
+		q.next = 0
+	}
+	org := q.orgs[q.next]
+	item := q.queues[org][0]
+	q.queues[org] = q.queues[org][1:]
+	q.len--
+	queueDepth.WithLabelValues(q.controller, org).Dec()
+	if len(q.queues[org]) == 0 {
+		delete(q.queues, org)
+		q.orgs = append(q.orgs[:q.next], q.orgs[q.next+1:]...)
+	} else {
+		q.next++
+	}
+	return item
+}
+
+// newFairWorkQueue returns a NewQueue function for controller.Options that builds the default rate limited work queue
+// on top of a fairQueue
+func newFairWorkQueue(orgs *orgIndex) func(string, workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	return func(name string, rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+		queue := workqueue.NewTypedWithConfig(workqueue.TypedQueueConfig[reconcile.Request]{
+			Name:  name,
+			Queue: newFairQueue(name, orgs.orgOf),
+		})
+		delaying := workqueue.NewTypedDelayingQueueWithConfig(workqueue.TypedDelayingQueueConfig[reconcile.Request]{
+			Name:  name,
+			Queue: queue,
+		})
+		return workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[reconcile.Request]{
+			Name:          name,
+			DelayingQueue: delaying,
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package controllers_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/controlplane-com/k8s-operator/pkg/controllers"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestFairQueue(t *testing.T) {
+	request := func(name string) reconcile.Request {
+		return reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: name}}
+	}
+	// The org of each test request is the part of its name before the dash
+	orgOf := func(req reconcile.Request) string {
+		org, _, _ := strings.Cut(req.Name, "-")
+		return org
+	}
+	q := controllers.NewFairQueue("test", orgOf)
+
+	// Scenario: A busy org doesn't hold up the requests of the others.
+	for _, name := range []string{"big-1", "big-2", "big-3", "small-1", "other-1", "small-2"} {
+		q.Push(request(name))
+	}
+	if q.Len() != 6 {
+		t.Fatalf("Len = %d, want 6", q.Len())
+	}
+	var got []string
+	for q.Len() > 0 {
+		got = append(got, q.Pop().Name)
+	}
+	want := []string{"big-1", "small-1", "other-1", "big-2", "small-2", "big-3"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Pop order = %v, want %v", got, want)
+	}
+
+	// Scenario: The queue keeps working after it was drained.
+	q.Push(request("small-3"))
+	if got := q.Pop().Name; got != "small-3" {
+		t.Errorf("Pop = %q, want small-3", got)
+	}
+}
@@ -0,0 +1,97 @@
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	// ConditionRemoteDeleted is set to True on a CR kept after its resource was deleted in Control Plane
+	ConditionRemoteDeleted = "RemoteDeleted"
+
+	ReasonRemoteDeleted = "RemoteDeleted"
+	ReasonRecreating    = "Recreating"
+	ReasonDeletingCR    = "DeletingCR"
+)
+
+// remoteDeletionPolicy returns what happens to the CR when its resource was deleted in Control Plane. The
+// cpln.io/remote-deletion-policy annotation wins over the policy of the kind, which wins over the global one.
+func (r *controller) remoteDeletionPolicy(cr *unstructured.Unstructured) string {
+	if policy := cr.GetAnnotations()[common.REMOTE_DELETION_POLICY_ANNOTATION]; policy != "" {
+		return policy
+	}
+	if policy := r.opts.RemoteDeletionPolicyPerKind[r.gvk.Kind]; policy != "" {
+		return policy
+	}
+	return r.opts.RemoteDeletionPolicy
+}
+
+// remoteDeleted handles a CR whose resource is gone from Control Plane. With the "recreate" policy, the CR is pushed
+// again. With "keep", the CR is left alone with a RemoteDeleted condition, until someone either deletes it or switches
+// it to "recreate". Otherwise, the CR is deleted.
+func (r *controller) remoteDeleted(ctx cpln.Context, log logr.Logger, cr *unstructured.Unstructured) (ctrl.Result, error) {
+	switch policy := r.remoteDeletionPolicy(cr); policy {
+	case common.REMOTE_DELETION_POLICY_RECREATE:
+		log.Info("Resource not found on Control Plane, recreating it from the CR")
+		r.event(cr, corev1.EventTypeWarning, ReasonRecreating, "Resource was deleted in Control Plane, recreating it from the CR")
+		removeCondition(cr, ConditionRemoteDeleted)
+		return r.push(ctx, log, cr)
+	case common.REMOTE_DELETION_POLICY_KEEP:
+		before := cr.DeepCopy()
+		message := fmt.Sprintf("Resource was deleted in Control Plane. Delete the CR, or set the %s annotation to %s to recreate it.",
+			common.REMOTE_DELETION_POLICY_ANNOTATION, common.REMOTE_DELETION_POLICY_RECREATE)
+		//Only announce it once, since the CR is reconciled again on every poll
+		if setCondition(cr, ConditionRemoteDeleted, "True", ReasonRemoteDeleted, message) {
+			log.Info("Resource not found on Control Plane, keeping the CR")
+			r.event(cr, corev1.EventTypeWarning, ReasonRemoteDeleted, message)
+		}
+		if err := r.writeChangedStatus(ctx, log, before, cr); err != nil {
+			return zeroResult, err
+		}
+		return r.defaultResult(), nil
+	default:
+		if r.opts.Shadow {
+			before := cr.DeepCopy()
+			wouldHaveDone(cr, r.shadowed(ctx, ShadowCleanup, "delete the CR, since the resource is gone from Control Plane"))
+			if err := r.writeChangedStatus(ctx, log, before, cr); err != nil {
+				return zeroResult, err
+			}
+			return r.defaultResult(), nil
+		}
+		log.Info("Resource not found on Control Plane, deleting from Kubernetes", "policy", policy)
+		r.event(cr, corev1.EventTypeWarning, ReasonDeletingCR, "Resource was deleted in Control Plane, deleting the CR")
+		if err := r.k8sConnector.Cleanup(ctx, cr); err != nil {
+			log.Error(err, "Error deleting from Kubernetes")
+			return zeroResult, err
+		}
+		return zeroResult, nil
+	}
+}
+
+// event records a Kubernetes Event on the CR. Shadow mode records no Events, since nothing they describe happens.
+func (r *controller) event(cr *unstructured.Unstructured, eventType, reason, message string) {
+	if r.recorder == nil || r.opts.Shadow {
+		return
+	}
+	r.recorder.Event(cr, eventType, reason, message)
+}
+
+// remoteDeletionHeld reports whether the CR is kept after its resource was deleted in Control Plane. Nothing is pushed
+// for it, so that a change to the CR doesn't recreate the resource before someone decides to.
+func (r *controller) remoteDeletionHeld(cr *unstructured.Unstructured) bool {
+	if r.remoteDeletionPolicy(cr) != common.REMOTE_DELETION_POLICY_KEEP {
+		return false
+	}
+	for _, c := range conditions(cr) {
+		if c["type"] == ConditionRemoteDeleted {
+			return c["status"] == "True"
+		}
+	}
+	return false
+}
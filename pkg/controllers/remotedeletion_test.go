@@ -0,0 +1,187 @@
+package controllers_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/controllers"
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRemoteDeleted(t *testing.T) {
+	ctx := cpln.NewContext(context.Background(), "acme", "main", "token")
+	newCR := func(policy string) *unstructured.Unstructured {
+		cr := &unstructured.Unstructured{Object: map[string]any{"org": "acme", "gvc": "main"}}
+		cr.SetGroupVersionKind(schema.GroupVersionKind{Group: common.API_GROUP, Version: common.API_REVISION, Kind: common.KIND_WORKLOAD})
+		cr.SetName("api")
+		cr.SetNamespace("default")
+		cr.SetFinalizers([]string{common.FINALIZER})
+		if policy != "" {
+			cr.SetAnnotations(map[string]string{common.REMOTE_DELETION_POLICY_ANNOTATION: policy})
+		}
+		return cr
+	}
+	remoteDeleted := func(opts controllers.Options, cr *unstructured.Unstructured) (client.Client, *record.FakeRecorder) {
+		t.Helper()
+		c := fake.NewClientBuilder().WithObjects(cr).WithStatusSubresource(cr).Build()
+		recorder := record.NewFakeRecorder(10)
+		if _, err := controllers.RemoteDeleted(ctx, c, recorder, opts, cr); err != nil {
+			t.Fatalf("remoteDeleted failed: %v", err)
+		}
+		return c, recorder
+	}
+
+	// Scenario: By default, the CR is deleted, as it always was.
+	c, recorder := remoteDeleted(controllers.Options{}, newCR(""))
+	if err := c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "api"}, newCR("")); !apierrors.IsNotFound(err) {
+		t.Errorf("get after delete = %v, want not found", err)
+	}
+	if got, want := <-recorder.Events, "Warning DeletingCR Resource was deleted in Control Plane, deleting the CR"; got != want {
+		t.Errorf("event = %q, want %q", got, want)
+	}
+
+	// Scenario: With "keep", the CR stays with a RemoteDeleted condition, announced once.
+	cr := newCR("")
+	opts := controllers.Options{RemoteDeletionPolicyPerKind: map[string]string{common.KIND_WORKLOAD: common.REMOTE_DELETION_POLICY_KEEP}}
+	c, recorder = remoteDeleted(opts, cr)
+	if _, err := controllers.RemoteDeleted(ctx, c, recorder, opts, cr); err != nil {
+		t.Fatalf("second remoteDeleted failed: %v", err)
+	}
+	kept := newCR("")
+	if err := c.Get(ctx, client.ObjectKeyFromObject(kept), kept); err != nil {
+		t.Fatalf("get after keep failed: %v", err)
+	}
+	conditions, _, _ := unstructured.NestedSlice(kept.Object, "status", "conditions")
+	if len(conditions) != 1 || conditions[0].(map[string]any)["type"] != controllers.ConditionRemoteDeleted {
+		t.Errorf("conditions = %v, want RemoteDeleted", conditions)
+	}
+	if got := len(recorder.Events); got != 1 {
+		t.Errorf("events = %d, want 1", got)
+	}
+
+	// Scenario: The annotation wins over the policy of the kind.
+	c, _ = remoteDeleted(opts, newCR(common.REMOTE_DELETION_POLICY_DELETE))
+	if err := c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "api"}, newCR("")); !apierrors.IsNotFound(err) {
+		t.Errorf("get after annotated delete = %v, want not found", err)
+	}
+
+	// Scenario: Shadow mode records the deletion instead of doing it.
+	c, recorder = remoteDeleted(controllers.Options{Shadow: true}, newCR(""))
+	if err := c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "api"}, newCR("")); err != nil {
+		t.Errorf("get in shadow mode = %v, want the CR", err)
+	}
+	if got := len(recorder.Events); got != 0 {
+		t.Errorf("events in shadow mode = %d, want none", got)
+	}
+}
+
+func TestRecreateRemoteDeleted(t *testing.T) {
+	// The stand-in API serves the resource until it's deleted, and stores whatever is pushed
+	var remote []byte
+	pushes := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			if remote == nil {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(remote)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if r.URL.Query().Get("dryRun") != "true" {
+			pushes++
+			remote = body
+		}
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+	remote, _ = json.Marshal(map[string]any{"name": "api", "kind": "workload", "spec": map[string]any{"image": "api:1"}})
+
+	ctx := context.Background()
+	gvk := schema.GroupVersionKind{Group: common.API_GROUP, Version: common.API_REVISION, Kind: common.KIND_WORKLOAD}
+	opts := controllers.Options{
+		APIURL: server.URL,
+		Credentials: cpln.CredentialProviderFunc(func(context.Context, string) (string, error) {
+			return "token", nil
+		}),
+	}
+	cr := &unstructured.Unstructured{Object: map[string]any{"org": "acme", "gvc": "main", "spec": map[string]any{"image": "api:1"}}}
+	cr.SetGroupVersionKind(gvk)
+	cr.SetName("api")
+	cr.SetNamespace("default")
+	cr.SetGeneration(1)
+	cr.SetAnnotations(map[string]string{common.REMOTE_DELETION_POLICY_ANNOTATION: common.REMOTE_DELETION_POLICY_KEEP})
+	cr.Object["status"] = map[string]any{"operator": map[string]any{"lastSyncedGeneration": int64(1)}}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+	k8s := fake.NewClientBuilder().WithObjects(cr, ns).WithStatusSubresource(cr).Build()
+	key := client.ObjectKeyFromObject(cr)
+	reconcile := func(policy string) *unstructured.Unstructured {
+		t.Helper()
+		stored := &unstructured.Unstructured{}
+		stored.SetGroupVersionKind(gvk)
+		if err := k8s.Get(ctx, key, stored); err != nil {
+			t.Fatal(err)
+		}
+		if stored.GetAnnotations()[common.REMOTE_DELETION_POLICY_ANNOTATION] != policy {
+			stored.SetAnnotations(map[string]string{common.REMOTE_DELETION_POLICY_ANNOTATION: policy})
+			if err := k8s.Update(ctx, stored); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, err := controllers.NewReconciler(k8s, opts, gvk).Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("reconcile failed: %v", err)
+		}
+		if err := k8s.Get(ctx, key, stored); err != nil {
+			t.Fatal(err)
+		}
+		return stored
+	}
+	remoteDeleted := func(cr *unstructured.Unstructured) bool {
+		conditions, _, _ := unstructured.NestedSlice(cr.Object, "status", "conditions")
+		for _, c := range conditions {
+			if c := c.(map[string]any); c["type"] == controllers.ConditionRemoteDeleted {
+				return c["status"] == "True"
+			}
+		}
+		return false
+	}
+
+	// Scenario: A kept CR whose resource is deleted in Control Plane pushes nothing.
+	remote = nil
+	if stored := reconcile(common.REMOTE_DELETION_POLICY_KEEP); !remoteDeleted(stored) || pushes != 0 {
+		t.Fatalf("kept: RemoteDeleted %v, %d pushes, want the condition and no push", remoteDeleted(stored), pushes)
+	}
+
+	// Scenario: Switched to "recreate", the resource is created again from the CR and the condition is cleared.
+	stored := reconcile(common.REMOTE_DELETION_POLICY_RECREATE)
+	if pushes != 1 || remote == nil {
+		t.Fatalf("recreate: %d pushes, want the resource created again", pushes)
+	}
+	var recreated map[string]any
+	if err := json.Unmarshal(remote, &recreated); err != nil || recreated["name"] != "api" {
+		t.Errorf("recreated = %s, %v, want the api workload", remote, err)
+	}
+	if remoteDeleted(stored) {
+		t.Error("RemoteDeleted is still set after the resource was recreated")
+	}
+
+	// Scenario: The recreated resource is found on the next pull, so it's not pushed again.
+	if stored := reconcile(common.REMOTE_DELETION_POLICY_RECREATE); pushes != 1 || remoteDeleted(stored) {
+		t.Errorf("after recreate: %d pushes, RemoteDeleted %v, want no further push", pushes, remoteDeleted(stored))
+	}
+}
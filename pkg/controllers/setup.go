@@ -0,0 +1,64 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	"github.com/controlplane-com/k8s-operator/pkg/mutators"
+	"github.com/controlplane-com/k8s-operator/pkg/realtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Setup adds the operator to a manager: a controller per managed kind, the webhook handlers, and the realtime syncs
+// those controllers start. Realtime syncs are closed when the manager stops.
+func Setup(mgr ctrl.Manager, opts Options) error {
+	opts = opts.withDefaults()
+	if opts.Credentials == nil {
+		opts.Credentials = cpln.NewSecretCredentialProvider(mgr.GetClient(), opts.namespace())
+	}
+
+	if !opts.DisableWebhook {
+		mgr.GetWebhookServer().Register("/mutate", &admission.Webhook{
+			Handler: mutators.CrMutator{
+				ManagesNamespace: opts.ManagesNamespace,
+				ManagesClass:     opts.ManagesClass,
+			},
+		})
+		mgr.GetWebhookServer().Register("/validate", &admission.Webhook{
+			Handler: mutators.DeletionValidator{
+				DeletionPolicy:   opts.DeletionPolicy,
+				ManagesNamespace: opts.ManagesNamespace,
+				ManagesClass:     opts.ManagesClass,
+			},
+		})
+	}
+	if opts.DisableControllers {
+		return nil
+	}
+
+	syncs := realtime.NewRegistry()
+	err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+		return syncs.Close()
+	}))
+	if err != nil {
+		return err
+	}
+	var feed *changeFeed
+	if opts.ChangeFeedURL != "" {
+		feed = newChangeFeed(mgr.GetCache(), opts, syncs)
+	}
+	var s *shards
+	if opts.Sharding {
+		s = newShards(mgr.GetClient(), mgr.GetAPIReader(), opts)
+		if err = mgr.Add(s); err != nil {
+			return err
+		}
+	}
+	if err = buildGenericControllers(mgr, opts, syncs, feed, s); err != nil {
+		return err
+	}
+	return buildSpecializedControllers(mgr, opts, syncs, s)
+}
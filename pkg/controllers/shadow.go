@@ -0,0 +1,194 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// The actions skipped in shadow mode
+const (
+	ShadowPush       = "Push"
+	ShadowRunActions = "RunActions"
+	ShadowPullWrite  = "PullWrite"
+	ShadowCleanup    = "Cleanup"
+	ShadowDelete     = "Delete"
+	ShadowChildWrite = "ChildWrite"
+)
+
+// maxWouldHaveDone is the number of skipped actions kept in status.operator.wouldHaveDone
+const maxWouldHaveDone = 20
+
+var shadowActions = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "cpln_operator_shadow_actions_total",
+	Help: "Number of actions skipped in shadow mode, by kind and action",
+}, []string{"kind", "action"})
+
+func init() {
+	metrics.Registry.MustRegister(shadowActions)
+}
+
+// shadowConnector forces every Put to a dry run and skips deletes, so that nothing is written to Control Plane in
+// shadow mode
+type shadowConnector struct {
+	cpln.Connector
+}
+
+func (s shadowConnector) Put(ctx cpln.Context, cr *unstructured.Unstructured, _ bool) (string, error) {
+	return s.Connector.Put(ctx, cr, true)
+}
+
+func (s shadowConnector) Delete(cpln.Context, *unstructured.Unstructured) error {
+	return nil
+}
+
+// shadowed logs and counts an action skipped in shadow mode, and returns its entry for status.operator.wouldHaveDone
+func (r *controller) shadowed(ctx context.Context, action string, description string) string {
+	log.FromContext(ctx).Info("Shadow mode is on, skipping", "action", action, "description", description)
+	shadowActions.WithLabelValues(r.gvk.Kind, action).Inc()
+	return fmt.Sprintf("%s: %s", action, description)
+}
+
+// wouldHaveDone adds an entry to status.operator.wouldHaveDone, unless it's already there. Only the latest
+// maxWouldHaveDone entries are kept.
+func wouldHaveDone(cr *unstructured.Unstructured, entry string) {
+	o := operatorStatus(cr)
+	entries, _ := o["wouldHaveDone"].([]any)
+	if slices.Contains(entries, any(entry)) {
+		return
+	}
+	entries = append(slices.Clone(entries), entry)
+	if len(entries) > maxWouldHaveDone {
+		entries = entries[len(entries)-maxWouldHaveDone:]
+	}
+	o["wouldHaveDone"] = entries
+}
+
+// shadowPush stands in for a push in shadow mode. The changes are planned with a dry run, as in plan mode, and the CR
+// is left unsynced.
+func (r *controller) shadowPush(ctx cpln.Context, log logr.Logger, cr *unstructured.Unstructured) (ctrl.Result, error) {
+	p, err := r.computePlan(ctx, log, cr)
+	if err != nil {
+		return zeroResult, err
+	}
+	before := cr.DeepCopy()
+	recordPlan(cr, p)
+	if p != noChanges {
+		changes := strings.Count(p, "\n") + 1
+		wouldHaveDone(cr, r.shadowed(ctx, ShadowPush, fmt.Sprintf("push generation %d with %d changes, see status.operator.plan", generation(cr), changes)))
+	}
+	if r.extension().RunActions != nil && actionsChanged(cr) {
+		wouldHaveDone(cr, r.shadowed(ctx, ShadowRunActions, fmt.Sprintf("run the actions requested by annotations on generation %d", generation(cr))))
+	}
+	if err := r.writeChangedStatus(ctx, log, before, cr); err != nil {
+		return zeroResult, err
+	}
+	return r.defaultResult(), nil
+}
+
+// shadowClient skips the writes of kind extensions in shadow mode, except to the status of the CR they were called for.
+// The skipped writes are kept until they are drained into the status of the CR.
+type shadowClient struct {
+	client.Client
+	parent  client.ObjectKey
+	skipped func(ctx context.Context, verb string, obj client.Object) string
+
+	m       sync.Mutex
+	entries []string
+}
+
+func (c *shadowClient) skip(ctx context.Context, verb string, obj client.Object) {
+	entry := c.skipped(ctx, verb, obj)
+	c.m.Lock()
+	defer c.m.Unlock()
+	if !slices.Contains(c.entries, entry) && len(c.entries) < maxWouldHaveDone {
+		c.entries = append(c.entries, entry)
+	}
+}
+
+// drain returns the writes skipped since the last drain
+func (c *shadowClient) drain() []string {
+	c.m.Lock()
+	defer c.m.Unlock()
+	entries := c.entries
+	c.entries = nil
+	return entries
+}
+
+func (c *shadowClient) Create(ctx context.Context, obj client.Object, _ ...client.CreateOption) error {
+	c.skip(ctx, "create", obj)
+	return nil
+}
+
+func (c *shadowClient) Update(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error {
+	c.skip(ctx, "update", obj)
+	return nil
+}
+
+func (c *shadowClient) Patch(ctx context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+	c.skip(ctx, "patch", obj)
+	return nil
+}
+
+func (c *shadowClient) Delete(ctx context.Context, obj client.Object, _ ...client.DeleteOption) error {
+	c.skip(ctx, "delete", obj)
+	return nil
+}
+
+func (c *shadowClient) DeleteAllOf(ctx context.Context, obj client.Object, _ ...client.DeleteAllOfOption) error {
+	c.skip(ctx, "delete all of", obj)
+	return nil
+}
+
+func (c *shadowClient) Status() client.SubResourceWriter {
+	return &shadowStatusWriter{SubResourceWriter: c.Client.Status(), client: c}
+}
+
+type shadowStatusWriter struct {
+	client.SubResourceWriter
+	client *shadowClient
+}
+
+func (w *shadowStatusWriter) Create(ctx context.Context, obj client.Object, _ client.Object, _ ...client.SubResourceCreateOption) error {
+	w.client.skip(ctx, "create the status of", obj)
+	return nil
+}
+
+func (w *shadowStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	if client.ObjectKeyFromObject(obj) == w.client.parent {
+		return w.SubResourceWriter.Update(ctx, obj, opts...)
+	}
+	w.client.skip(ctx, "update the status of", obj)
+	return nil
+}
+
+func (w *shadowStatusWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	if client.ObjectKeyFromObject(obj) == w.client.parent {
+		return w.SubResourceWriter.Patch(ctx, obj, patch, opts...)
+	}
+	w.client.skip(ctx, "patch the status of", obj)
+	return nil
+}
+
+// shadowClient returns the client for the extensions called for the CR
+func (r *controller) shadowClient(cr *unstructured.Unstructured) *shadowClient {
+	return &shadowClient{
+		Client: r.Client,
+		parent: client.ObjectKeyFromObject(cr),
+		skipped: func(ctx context.Context, verb string, obj client.Object) string {
+			kind := strings.ToLower(obj.GetObjectKind().GroupVersionKind().Kind)
+			return r.shadowed(ctx, ShadowChildWrite, fmt.Sprintf("%s %s %s", verb, kind, obj.GetName()))
+		},
+	}
+}
@@ -0,0 +1,88 @@
+package controllers_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/controllers"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestShadowClient(t *testing.T) {
+	ctx := context.Background()
+	newCR := func(kind, name string) *unstructured.Unstructured {
+		cr := &unstructured.Unstructured{Object: map[string]any{"org": "acme", "gvc": "main"}}
+		cr.SetGroupVersionKind(schema.GroupVersionKind{Group: common.API_GROUP, Version: common.API_REVISION, Kind: kind})
+		cr.SetName(name)
+		cr.SetNamespace("default")
+		return cr
+	}
+	parent := newCR(common.KIND_WORKLOAD, "api")
+	existing := newCR(common.KIND_DEPLOYMENT, "aws-eu-central-1.api")
+	c := fake.NewClientBuilder().WithObjects(parent, existing).WithStatusSubresource(parent, existing).Build()
+	shadow, drain := controllers.ShadowClient(c, parent)
+
+	// Scenario: Writes to child CRs are skipped and reported.
+	if err := shadow.Create(ctx, newCR(common.KIND_DEPLOYMENT, "gcp-us-east1.api")); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if err := shadow.Delete(ctx, existing); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	existing.Object["status"] = map[string]any{"ready": true}
+	if err := shadow.Status().Update(ctx, existing); err != nil {
+		t.Fatalf("status update failed: %v", err)
+	}
+	children := &unstructured.UnstructuredList{}
+	children.SetGroupVersionKind(existing.GroupVersionKind())
+	if err := c.List(ctx, children); err != nil {
+		t.Fatal(err)
+	}
+	if len(children.Items) != 1 || children.Items[0].Object["status"] != nil {
+		t.Errorf("children = %v, want only the existing one, unchanged", children.Items)
+	}
+	want := []string{
+		"ChildWrite: create deployment gcp-us-east1.api",
+		"ChildWrite: delete deployment aws-eu-central-1.api",
+		"ChildWrite: update the status of deployment aws-eu-central-1.api",
+	}
+	if got := drain(); !slices.Equal(got, want) {
+		t.Errorf("skipped = %v, want %v", got, want)
+	}
+	if got := drain(); len(got) != 0 {
+		t.Errorf("skipped after draining = %v, want none", got)
+	}
+
+	// Scenario: The status of the parent is still written.
+	parent.Object["status"] = map[string]any{"phase": "Ready"}
+	if err := shadow.Status().Update(ctx, parent); err != nil {
+		t.Fatalf("status update failed: %v", err)
+	}
+	got := newCR(common.KIND_WORKLOAD, "api")
+	if err := c.Get(ctx, client.ObjectKeyFromObject(parent), got); err != nil {
+		t.Fatal(err)
+	}
+	if phase, _, _ := unstructured.NestedString(got.Object, "status", "phase"); phase != "Ready" {
+		t.Errorf("parent phase = %q, want Ready", phase)
+	}
+}
+
+func TestWouldHaveDone(t *testing.T) {
+	cr := &unstructured.Unstructured{Object: map[string]any{}}
+	for i := 0; i < 25; i++ {
+		controllers.WouldHaveDone(cr, fmt.Sprintf("Push: %d", i))
+		controllers.WouldHaveDone(cr, fmt.Sprintf("Push: %d", i))
+	}
+	entries, _, _ := unstructured.NestedSlice(cr.Object, "status", "operator", "wouldHaveDone")
+
+	// Scenario: Entries are recorded once, and only the latest are kept.
+	if len(entries) != 20 || entries[0] != "Push: 5" || entries[19] != "Push: 24" {
+		t.Errorf("entries = %v, want Push: 5 to Push: 24", entries)
+	}
+}
@@ -0,0 +1,316 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// shardVirtualNodes is the number of points each replica gets on the hash ring. More points spread the CRs more
+// evenly between the replicas.
+const shardVirtualNodes = 128
+
+// shards splits the CRs between the replicas of the operator. Each replica renews a Lease of its own, and every replica
+// builds the same consistent-hash ring from the live Leases, so they agree on the owner of each CR without talking to
+// each other. When a replica comes or goes, only the CRs on its part of the ring move.
+//
+// A replica learns about a change of membership on its next sync, so for a while after a change the previous owner of
+// a CR may still reconcile it. The new owner holds the CRs it takes over from a replica that is still alive, until that
+// replica renewed its Lease since the change, and so rebuilt its ring, or its Lease expired or was released.
+type shards struct {
+	client        client.Client
+	reader        client.Reader
+	identity      string
+	namespace     string
+	class         string
+	leaseDuration time.Duration
+
+	m        sync.RWMutex
+	members  []string
+	ring     *hashRing
+	handoffs []handoff
+	onChange []func()
+}
+
+// handoff is a ring this replica replaced, whose owners may still be reconciling their CRs
+type handoff struct {
+	ring *hashRing
+	// since is when this replica replaced the ring
+	since time.Time
+	// pending are the other replicas that have yet to renew their Lease since then
+	pending []string
+}
+
+func newShards(c client.Client, reader client.Reader, opts Options) *shards {
+	return &shards{
+		client:        c,
+		reader:        reader,
+		identity:      opts.ShardIdentity,
+		namespace:     opts.namespace(),
+		class:         opts.operatorClass(),
+		leaseDuration: opts.ShardLeaseDuration,
+	}
+}
+
+// NeedLeaderElection is false, since every replica takes part in sharding
+func (s *shards) NeedLeaderElection() bool {
+	return false
+}
+
+// Start renews the Lease of this replica until the context is done, then releases it so the other replicas take over
+// right away
+func (s *shards) Start(ctx context.Context) error {
+	l := log.FromContext(ctx).WithValues("identity", s.identity)
+	ticker := time.NewTicker(s.leaseDuration / 3)
+	defer ticker.Stop()
+	for {
+		if err := s.sync(ctx); err != nil {
+			l.Error(err, "Failed to sync shard membership")
+		}
+		select {
+		case <-ctx.Done():
+			release, cancel := context.WithTimeout(context.Background(), s.leaseDuration)
+			defer cancel()
+			if err := s.client.Delete(release, s.lease(time.Now())); err != nil && !k8serrors.IsNotFound(err) {
+				l.Error(err, "Failed to release the shard lease")
+			}
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// onMembershipChange registers a function to call whenever the ring changes, so that CRs are handed over
+func (s *shards) onMembershipChange(f func()) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.onChange = append(s.onChange, f)
+}
+
+// owns reports whether this replica reconciles the CR. Every CR is owned when sharding is disabled, and none until the
+// first membership sync. A CR taken over from another replica isn't owned until that replica let go of it.
+func (s *shards) owns(cr client.Object) bool {
+	if s == nil {
+		return true
+	}
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.ring == nil {
+		return false
+	}
+	key := shardKey(cr)
+	if s.ring.owner(key) != s.identity {
+		return false
+	}
+	for _, h := range s.handoffs {
+		if previous := h.ring.owner(key); previous != s.identity && slices.Contains(h.pending, previous) {
+			return false
+		}
+	}
+	return true
+}
+
+// sync renews the Lease of this replica and rebuilds the ring from the live Leases
+func (s *shards) sync(ctx context.Context) error {
+	now := time.Now()
+	current := &coordinationv1.Lease{}
+	err := s.reader.Get(ctx, client.ObjectKey{Namespace: s.namespace, Name: shardLeaseName(s.identity)}, current)
+	switch {
+	case k8serrors.IsNotFound(err):
+		if err = s.client.Create(ctx, s.lease(now)); err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	default:
+		current.Spec.RenewTime = &metav1.MicroTime{Time: now}
+		current.Spec.LeaseDurationSeconds = ptr.To(int32(s.leaseDuration.Seconds()))
+		if err = s.client.Update(ctx, current); err != nil {
+			return err
+		}
+	}
+
+	leases := &coordinationv1.LeaseList{}
+	err = s.reader.List(ctx, leases, client.InNamespace(s.namespace),
+		client.MatchingLabels{common.SHARD_LABEL: s.class})
+	if err != nil {
+		return err
+	}
+	members := []string{s.identity}
+	renewed := map[string]time.Time{}
+	for _, lease := range leases.Items {
+		holder := ptr.Deref(lease.Spec.HolderIdentity, "")
+		if holder == "" || holder == s.identity || lease.Spec.RenewTime == nil {
+			continue
+		}
+		expires := lease.Spec.RenewTime.Add(time.Duration(ptr.Deref(lease.Spec.LeaseDurationSeconds, 0)) * time.Second)
+		if expires.After(now) {
+			members = append(members, holder)
+			renewed[holder] = lease.Spec.RenewTime.Time
+			continue
+		}
+		//Replicas are named after their pod, so the Lease of a replica that is gone is never renewed again
+		if err := s.client.Delete(ctx, &lease); err != nil && !k8serrors.IsNotFound(err) {
+			log.FromContext(ctx).Error(err, "Failed to delete an expired shard lease", "holder", holder)
+		}
+	}
+	slices.Sort(members)
+
+	s.m.Lock()
+	handedOver := s.releaseHandoffs(renewed)
+	if slices.Equal(members, s.members) {
+		onChange := slices.Clone(s.onChange)
+		s.m.Unlock()
+		//The CRs held for a handoff that completed are picked up now
+		if handedOver {
+			for _, f := range onChange {
+				go f()
+			}
+		}
+		return nil
+	}
+	log.FromContext(ctx).Info("Shard membership changed, rebalancing", "members", members)
+	others := slices.DeleteFunc(slices.Clone(members), func(member string) bool { return member == s.identity })
+	if len(others) > 0 {
+		//Until its first sync, this replica isn't on the ring of the others yet
+		previous := s.ring
+		if previous == nil {
+			previous = newHashRing(others)
+		}
+		//The Lease of this replica was written by now, so the others see it on their next renewal
+		s.handoffs = append(s.handoffs, handoff{ring: previous, since: time.Now(), pending: others})
+	}
+	s.members = members
+	s.ring = newHashRing(members)
+	onChange := slices.Clone(s.onChange)
+	s.m.Unlock()
+	for _, f := range onChange {
+		go f()
+	}
+	return nil
+}
+
+// releaseHandoffs drops the replicas that renewed their Lease since a handoff began, or whose Lease expired or was
+// released, from the ones it waits for. It reports whether any handoff completed.
+func (s *shards) releaseHandoffs(renewed map[string]time.Time) bool {
+	completed := false
+	pending := s.handoffs[:0]
+	for _, h := range s.handoffs {
+		h.pending = slices.DeleteFunc(h.pending, func(member string) bool {
+			at, alive := renewed[member]
+			return !alive || at.After(h.since)
+		})
+		if len(h.pending) == 0 {
+			completed = true
+			continue
+		}
+		pending = append(pending, h)
+	}
+	s.handoffs = pending
+	return completed
+}
+
+func (s *shards) lease(now time.Time) *coordinationv1.Lease {
+	return &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      shardLeaseName(s.identity),
+			Namespace: s.namespace,
+			Labels:    map[string]string{common.SHARD_LABEL: s.class},
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       ptr.To(s.identity),
+			LeaseDurationSeconds: ptr.To(int32(s.leaseDuration.Seconds())),
+			RenewTime:            &metav1.MicroTime{Time: now},
+		},
+	}
+}
+
+func shardLeaseName(identity string) string {
+	return fmt.Sprintf("cpln-operator-shard-%s", identity)
+}
+
+// shardKey places CRs of the same org, gvc and namespace on the same replica, so they share its realtime syncs
+func shardKey(cr client.Object) string {
+	var org, gvc string
+	if u, ok := cr.(*unstructured.Unstructured); ok {
+		org, _ = u.Object["org"].(string)
+		gvc, _ = u.Object["gvc"].(string)
+	}
+	return fmt.Sprintf("%s/%s/%s", org, gvc, cr.GetNamespace())
+}
+
+// hashRing is a consistent-hash ring of the replicas
+type hashRing struct {
+	hashes []uint64
+	owners map[uint64]string
+}
+
+func newHashRing(members []string) *hashRing {
+	r := &hashRing{owners: map[uint64]string{}}
+	for _, member := range members {
+		for i := 0; i < shardVirtualNodes; i++ {
+			h := hashKey(fmt.Sprintf("%s#%d", member, i))
+			r.hashes = append(r.hashes, h)
+			r.owners[h] = member
+		}
+	}
+	slices.Sort(r.hashes)
+	return r
+}
+
+// owner returns the replica that owns the key: the first one at or after its hash on the ring
+func (r *hashRing) owner(key string) string {
+	if len(r.hashes) == 0 {
+		return ""
+	}
+	h := hashKey(key)
+	i := sort.Search(len(r.hashes), func(i int) bool { return r.hashes[i] >= h })
+	if i == len(r.hashes) {
+		i = 0
+	}
+	return r.owners[r.hashes[i]]
+}
+
+// hashKey hashes the key with FNV-1a, then mixes the bits with the splitmix64 finalizer, since FNV alone barely spreads
+// keys that only differ in their last characters, like the namespaces of one org
+func hashKey(key string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	x := h.Sum64()
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// resync sends every CR of the kind to its controller, so that the CRs of a new owner are picked up, and the realtime
+// syncs of the previous owner released
+func resync(reader client.Reader, gvk schema.GroupVersionKind, events chan<- event.GenericEvent) {
+	ctx := context.Background()
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := reader.List(ctx, list); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list resources to rebalance", "kind", gvk.Kind)
+		return
+	}
+	for i := range list.Items {
+		events <- event.GenericEvent{Object: &list.Items[i]}
+	}
+}
@@ -0,0 +1,149 @@
+package controllers_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/controllers"
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestHashRing(t *testing.T) {
+	owner := controllers.HashRingOwner([]string{"operator-a", "operator-b", "operator-c"})
+	keys := make([]string, 3000)
+	counts := map[string]int{}
+	for i := range keys {
+		keys[i] = fmt.Sprintf("org-%d/gvc/namespace", i)
+		counts[owner(keys[i])]++
+	}
+
+	// Scenario: The keys are spread over every replica.
+	for _, member := range []string{"operator-a", "operator-b", "operator-c"} {
+		if counts[member] < 600 || counts[member] > 1400 {
+			t.Errorf("%s owns %d of %d keys, want a fair share", member, counts[member], len(keys))
+		}
+	}
+
+	// Scenario: When a replica leaves, only its keys move.
+	without := controllers.HashRingOwner([]string{"operator-a", "operator-c"})
+	for _, key := range keys {
+		if before, after := owner(key), without(key); before != "operator-b" && before != after {
+			t.Fatalf("%s moved from %s to %s, but only the keys of operator-b should move", key, before, after)
+		}
+	}
+}
+
+func TestShardMembership(t *testing.T) {
+	ctx := context.Background()
+	lease := func(holder, class string, renewed time.Time) *coordinationv1.Lease {
+		return &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cpln-operator-shard-" + holder,
+				Namespace: common.CONTROLLER_NAMESPACE,
+				Labels:    map[string]string{common.SHARD_LABEL: class},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To(holder),
+				LeaseDurationSeconds: ptr.To(int32(15)),
+				RenewTime:            &metav1.MicroTime{Time: renewed},
+			},
+		}
+	}
+	c := fake.NewClientBuilder().WithObjects(
+		lease("gone", common.DEFAULT_OPERATOR_CLASS, time.Now().Add(-time.Hour)),
+		lease("canary", "canary", time.Now()),
+	).Build()
+	syncA, ownsA := controllers.NewShard(c, controllers.Options{ShardIdentity: "operator-a"})
+	syncB, ownsB := controllers.NewShard(c, controllers.Options{ShardIdentity: "operator-b"})
+
+	cr := &unstructured.Unstructured{Object: map[string]any{"org": "acme", "gvc": "main"}}
+	cr.SetNamespace("default")
+
+	// Scenario: No CR is owned before the first membership sync.
+	if ownsA(cr) {
+		t.Errorf("operator-a owns a CR before joining")
+	}
+
+	// Scenario: A replica joining holds the CRs it takes over until their previous owner renewed its Lease, and so saw
+	// it join.
+	for _, sync := range []func(context.Context) error{syncA, syncB} {
+		if err := sync(ctx); err != nil {
+			t.Fatalf("sync failed: %v", err)
+		}
+	}
+	for i := 0; i < 100; i++ {
+		cr.SetNamespace(fmt.Sprintf("namespace-%d", i))
+		if ownsB(cr) {
+			t.Fatalf("%s: owned by operator-b before operator-a saw it join", cr.GetNamespace())
+		}
+	}
+
+	// Scenario: Once both replicas renewed their Leases, every CR is owned by exactly one of them. Expired Leases and
+	// those of other classes are left out.
+	for _, sync := range []func(context.Context) error{syncA, syncB} {
+		if err := sync(ctx); err != nil {
+			t.Fatalf("sync failed: %v", err)
+		}
+	}
+	ownedByA, ownedByB := 0, 0
+	for i := 0; i < 100; i++ {
+		cr.SetNamespace(fmt.Sprintf("namespace-%d", i))
+		a, b := ownsA(cr), ownsB(cr)
+		if a == b {
+			t.Fatalf("%s: owned by operator-a = %v, operator-b = %v, want exactly one owner", cr.GetNamespace(), a, b)
+		}
+		if a {
+			ownedByA++
+		} else {
+			ownedByB++
+		}
+	}
+	if ownedByA == 0 || ownedByB == 0 {
+		t.Errorf("operator-a owns %d and operator-b %d CRs, want both to own some", ownedByA, ownedByB)
+	}
+
+	// Scenario: The expired Lease is cleaned up.
+	err := c.Get(ctx, client.ObjectKey{Namespace: common.CONTROLLER_NAMESPACE, Name: "cpln-operator-shard-gone"}, &coordinationv1.Lease{})
+	if err == nil {
+		t.Errorf("the expired lease was not deleted")
+	}
+}
+
+func TestReconcileOtherShard(t *testing.T) {
+	// Scenario: A CR of another replica is skipped without fetching a token for its org.
+	var tokens atomic.Int32
+	gvk := schema.GroupVersionKind{Group: common.API_GROUP, Version: common.API_REVISION, Kind: common.KIND_WORKLOAD}
+	cr := &unstructured.Unstructured{Object: map[string]any{"org": "acme", "gvc": "main"}}
+	cr.SetGroupVersionKind(gvk)
+	cr.SetName("api")
+	cr.SetNamespace("default")
+	c := fake.NewClientBuilder().WithObjects(cr).Build()
+	opts := controllers.Options{
+		Sharding:      true,
+		ShardIdentity: "operator-a",
+		Credentials: cpln.CredentialProviderFunc(func(context.Context, string) (string, error) {
+			tokens.Add(1)
+			return "token", nil
+		}),
+	}
+
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(cr)}
+	if _, err := controllers.NewReconciler(c, opts, gvk).Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("reconcile failed: %v", err)
+	}
+	if n := tokens.Load(); n != 0 {
+		t.Errorf("fetched %d tokens for a CR of another replica, want none", n)
+	}
+}
@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"errors"
 	"fmt"
 	"github.com/controlplane-com/k8s-operator/pkg/common"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -25,6 +26,12 @@ func resourcePolicy(cr *unstructured.Unstructured) string {
 	return p.(string)
 }
 
+// ConditionSynced is set to False, with the class of the error as its reason, while syncing with Control Plane fails
+const ConditionSynced = "Synced"
+
+// ConditionReady follows the health of the resource, next to the phase
+const ConditionReady = "Ready"
+
 func timeUntilNextSync(cr *unstructured.Unstructured) (time.Time, time.Duration) {
 	st := operatorStatus(cr)
 	_, ok := st["validationError"]
@@ -41,6 +48,11 @@ func timeUntilNextSync(cr *unstructured.Unstructured) (time.Time, time.Duration)
 
 func getNextRetry(cr *unstructured.Unstructured) time.Time {
 	st := operatorStatus(cr)
+	if nextRetryStr, _ := st["nextRetryTime"].(string); nextRetryStr != "" {
+		if nextRetry, err := time.Parse(time.RFC3339, nextRetryStr); err == nil {
+			return nextRetry
+		}
+	}
 	lastRetryStr, _ := st["lastSyncTime"].(string)
 	if lastRetryStr == "" {
 		return time.Now().UTC()
@@ -54,6 +66,25 @@ func getNextRetry(cr *unstructured.Unstructured) time.Time {
 	return nextRetry
 }
 
+// isParked reports whether the current generation failed with an error that retrying can't fix. Such a CR isn't
+// synced again until its generation changes.
+func isParked(cr *unstructured.Unstructured) bool {
+	st := operatorStatus(cr)
+	if _, ok := st["validationError"]; !ok {
+		return false
+	}
+	if g, _ := st["lastProcessedGeneration"].(int64); g != generation(cr) {
+		return false
+	}
+	for _, c := range conditions(cr) {
+		if c["type"] == ConditionSynced {
+			reason, _ := c["reason"].(string)
+			return common.IsPermanentError(reason)
+		}
+	}
+	return false
+}
+
 func getSyncFailureMessage(nextRetry time.Time) string {
 	return fmt.Sprintf("resource status is in sync failure state. Sync will be retried after %s", nextRetry.Format(time.RFC3339))
 }
@@ -103,12 +134,7 @@ func ready(cr *unstructured.Unstructured) {
 	}
 	status := cr.Object["status"].(map[string]any)
 	status["phase"] = "Ready"
-	status["conditions"] = []map[string]any{
-		{
-			"status": "True",
-			"type":   "Ready",
-		},
-	}
+	setCondition(cr, ConditionReady, "True", "", "")
 	op := operatorStatus(cr)
 	op["healthStatusMessage"] = ""
 }
@@ -135,12 +161,7 @@ func unhealthy(cr *unstructured.Unstructured) {
 	}
 	status := cr.Object["status"].(map[string]any)
 	status["phase"] = "Unhealthy"
-	status["conditions"] = []map[string]any{
-		{
-			"status": "False",
-			"type":   "Ready",
-		},
-	}
+	setCondition(cr, ConditionReady, "False", "", "")
 }
 
 func isProgressing(cr *unstructured.Unstructured) bool {
@@ -160,12 +181,7 @@ func progressing(cr *unstructured.Unstructured) {
 	}
 	status := cr.Object["status"].(map[string]any)
 	status["phase"] = "Pending"
-	status["conditions"] = []map[string]any{
-		{
-			"status": "False",
-			"type":   "Ready",
-		},
-	}
+	setCondition(cr, ConditionReady, "False", "", "")
 }
 
 func isSuspended(cr *unstructured.Unstructured) bool {
@@ -185,23 +201,29 @@ func suspended(cr *unstructured.Unstructured) {
 	}
 	status := cr.Object["status"].(map[string]any)
 	status["phase"] = "Suspended"
-	status["conditions"] = []map[string]any{
-		{
-			"status": "False",
-			"type":   "Ready",
-		},
-	}
+	setCondition(cr, ConditionReady, "False", "", "")
 }
 
 func synced(cr *unstructured.Unstructured, downstreamOnly bool, newStatus any) {
 	o := operatorStatus(cr)
 	g := generation(cr)
+	//Record when the current generation was first synced. Polls that find nothing new leave it alone, so that unchanged
+	//resources don't get a status update (and another reconcile) on every poll
+	if lastSynced, ok := o["lastSyncedGeneration"].(int64); !downstreamOnly && (!ok || lastSynced != g) {
+		o["lastSyncedGenerationTime"] = time.Now().UTC().Format(time.RFC3339)
+	}
 	o["lastSyncedGeneration"] = g
 	o["lastProcessedGeneration"] = g
 	o["downstreamOnly"] = downstreamOnly
 	delete(o, "lastSyncTime")
+	delete(o, "nextRetryTime")
 	delete(o, "syncRetries")
 	delete(o, "validationError")
+	//Once synced, there is nothing left to plan
+	delete(o, "plan")
+	delete(o, "planHash")
+	removeCondition(cr, ConditionSynced)
+	removeCondition(cr, ConditionRemoteDeleted)
 
 	st := cr.Object["status"].(map[string]any)
 	if m, ok := newStatus.(map[string]any); ok {
@@ -214,18 +236,118 @@ func synced(cr *unstructured.Unstructured, downstreamOnly bool, newStatus any) {
 	}
 }
 
-func syncFailed(cr *unstructured.Unstructured, errorMessage string) {
-	if errorMessage == "" {
-		errorMessage = "sync failed, but no error message provided"
+// syncFailed records a failed sync, classifying the error and scheduling the next retry. It returns the class of
+// the error.
+func syncFailed(cr *unstructured.Unstructured, err error) string {
+	errorMessage := "sync failed, but no error message provided"
+	if err != nil && err.Error() != "" {
+		errorMessage = err.Error()
 	}
+	class := common.ClassifyError(err)
 	o := operatorStatus(cr)
 	o["validationError"] = errorMessage
 	o["lastProcessedGeneration"] = generation(cr)
-	o["lastSyncTime"] = time.Now().UTC().Format(time.RFC3339)
+	now := time.Now().UTC()
+	o["lastSyncTime"] = now.Format(time.RFC3339)
 	r, ok := o["syncRetries"].(int64)
 	if !ok {
-		o["syncRetries"] = int64(0)
+		r = 0
 	} else {
-		o["syncRetries"] = r + 1
+		r = r + 1
+	}
+	o["syncRetries"] = r
+	var retryAfter time.Duration
+	var apiErr *common.APIError
+	if errors.As(err, &apiErr) {
+		retryAfter = apiErr.RetryAfter
+	}
+	o["nextRetryTime"] = now.Add(common.GetJitteredRetryDuration(int(r), retryAfter)).Format(time.RFC3339)
+	setCondition(cr, ConditionSynced, "False", class, errorMessage)
+	return class
+}
+
+func conditions(cr *unstructured.Unstructured) []map[string]any {
+	st, ok := cr.Object["status"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	switch c := st["conditions"].(type) {
+	case []map[string]any:
+		return c
+	case []any:
+		var out []map[string]any
+		for _, item := range c {
+			if m, ok := item.(map[string]any); ok {
+				out = append(out, m)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+// setCondition adds a condition to the CR, or updates the existing condition of the same type. It reports whether the
+// conditions changed.
+func setCondition(cr *unstructured.Unstructured, conditionType string, status string, reason string, message string) bool {
+	condition := map[string]any{
+		"type":   conditionType,
+		"status": status,
+	}
+	if reason != "" {
+		condition["reason"] = reason
+	}
+	if message != "" {
+		condition["message"] = message
+	}
+	operatorStatus(cr)
+	current := conditions(cr)
+	for i, c := range current {
+		if c["type"] != conditionType {
+			continue
+		}
+		if c["status"] == status && c["reason"] == condition["reason"] && c["message"] == condition["message"] {
+			return false
+		}
+		current[i] = condition
+		setConditions(cr, current)
+		return true
+	}
+	setConditions(cr, append(current, condition))
+	return true
+}
+
+// removeCondition removes the condition of the given type from the CR. It reports whether the conditions changed.
+func removeCondition(cr *unstructured.Unstructured, conditionType string) bool {
+	current := conditions(cr)
+	var kept []map[string]any
+	for _, c := range current {
+		if c["type"] != conditionType {
+			kept = append(kept, c)
+		}
 	}
+	if len(kept) == len(current) {
+		return false
+	}
+	setConditions(cr, kept)
+	return true
+}
+
+// setConditions stores the conditions as []any, so that the CR can still be deep copied
+func setConditions(cr *unstructured.Unstructured, c []map[string]any) {
+	out := make([]any, 0, len(c))
+	for _, condition := range c {
+		out = append(out, condition)
+	}
+	cr.Object["status"].(map[string]any)["conditions"] = out
+}
+
+// resetRetries clears the backoff of earlier sync failures, so the next sync is attempted right away, even if the
+// last error was permanent
+func resetRetries(cr *unstructured.Unstructured) {
+	o := operatorStatus(cr)
+	delete(o, "syncRetries")
+	delete(o, "lastSyncTime")
+	delete(o, "nextRetryTime")
+	removeCondition(cr, ConditionSynced)
 }
@@ -0,0 +1,123 @@
+package controllers_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/controllers"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestSetCondition(t *testing.T) {
+	cr := &unstructured.Unstructured{Object: map[string]any{}}
+	controllers.Ready(cr)
+
+	// Scenario: A new condition is appended next to Ready.
+	if !controllers.SetCondition(cr, controllers.ConditionPaused, "True", controllers.ReasonPausedByAnnotation, "") {
+		t.Errorf("SetCondition reported no change for a new condition")
+	}
+	conditions, _, _ := unstructured.NestedSlice(cr.Object, "status", "conditions")
+	if len(conditions) != 2 {
+		t.Fatalf("conditions = %v, want Ready and Paused", conditions)
+	}
+
+	// Scenario: Setting the same condition again is not a change, so the status isn't rewritten.
+	if controllers.SetCondition(cr, controllers.ConditionPaused, "True", controllers.ReasonPausedByAnnotation, "") {
+		t.Errorf("SetCondition reported a change for an identical condition")
+	}
+
+	// Scenario: A different reason replaces the condition in place.
+	if !controllers.SetCondition(cr, controllers.ConditionPaused, "True", controllers.ReasonMaintenance, "") {
+		t.Errorf("SetCondition reported no change for a new reason")
+	}
+	conditions, _, _ = unstructured.NestedSlice(cr.Object, "status", "conditions")
+	if len(conditions) != 2 || conditions[1].(map[string]any)["reason"] != controllers.ReasonMaintenance {
+		t.Errorf("conditions = %v, want the Paused condition updated in place", conditions)
+	}
+
+	// Scenario: Removing the condition keeps the others.
+	if !controllers.RemoveCondition(cr, controllers.ConditionPaused) {
+		t.Errorf("RemoveCondition reported no change")
+	}
+	if controllers.RemoveCondition(cr, controllers.ConditionPaused) {
+		t.Errorf("RemoveCondition reported a change for a missing condition")
+	}
+	conditions, _, _ = unstructured.NestedSlice(cr.Object, "status", "conditions")
+	if len(conditions) != 1 || conditions[0].(map[string]any)["type"] != "Ready" {
+		t.Errorf("conditions = %v, want only Ready", conditions)
+	}
+}
+
+func TestHealthKeepsOtherConditions(t *testing.T) {
+	cr := &unstructured.Unstructured{Object: map[string]any{}}
+	controllers.SetCondition(cr, controllers.ConditionSynced, "False", "Validation", "")
+	controllers.SetCondition(cr, controllers.ConditionPaused, "True", controllers.ReasonPausedByAnnotation, "")
+
+	// Scenario: Every health evaluation only replaces the Ready condition.
+	for _, setHealth := range []func(*unstructured.Unstructured){controllers.Ready, controllers.Unhealthy, controllers.Progressing, controllers.Suspended, controllers.Ready} {
+		setHealth(cr)
+	}
+	conditions, _, err := unstructured.NestedSlice(cr.Object, "status", "conditions")
+	if err != nil {
+		t.Fatalf("conditions aren't deep-copyable: %v", err)
+	}
+	var types []any
+	for _, c := range conditions {
+		types = append(types, c.(map[string]any)["type"])
+	}
+	if len(types) != 3 || types[0] != controllers.ConditionSynced || types[1] != controllers.ConditionPaused || types[2] != controllers.ConditionReady {
+		t.Errorf("condition types = %v, want Synced, Paused and Ready", types)
+	}
+	if status := conditions[2].(map[string]any)["status"]; status != "True" {
+		t.Errorf("Ready = %v, want True", status)
+	}
+	if cr.DeepCopy() == nil {
+		t.Error("CR can't be deep copied")
+	}
+}
+
+func TestSyncFailedParksValidationErrors(t *testing.T) {
+	newCR := func() *unstructured.Unstructured {
+		cr := &unstructured.Unstructured{Object: map[string]any{}}
+		cr.SetGeneration(2)
+		return cr
+	}
+
+	// Scenario: A validation error is recorded as the reason of the Synced condition and parks the CR.
+	cr := newCR()
+	class := controllers.SyncFailed(cr, &common.APIError{Method: "PUT", StatusCode: http.StatusBadRequest, Body: "invalid"})
+	if class != common.ErrorClassValidation {
+		t.Errorf("class = %q, want %q", class, common.ErrorClassValidation)
+	}
+	conditions, _, _ := unstructured.NestedSlice(cr.Object, "status", "conditions")
+	if len(conditions) != 1 || conditions[0].(map[string]any)["reason"] != common.ErrorClassValidation {
+		t.Errorf("conditions = %v, want a Synced condition with reason %s", conditions, common.ErrorClassValidation)
+	}
+	if !controllers.IsParked(cr) {
+		t.Errorf("a CR that failed validation should be parked")
+	}
+
+	// Scenario: A new generation is tried again.
+	cr.SetGeneration(3)
+	if controllers.IsParked(cr) {
+		t.Errorf("a new generation should not be parked")
+	}
+
+	// Scenario: Transient errors are retried.
+	cr = newCR()
+	controllers.SyncFailed(cr, &common.APIError{Method: "PUT", StatusCode: http.StatusServiceUnavailable})
+	if controllers.IsParked(cr) {
+		t.Errorf("a CR that failed with a transient error should not be parked")
+	}
+	if next, _, _ := unstructured.NestedString(cr.Object, "status", "operator", "nextRetryTime"); next == "" {
+		t.Errorf("nextRetryTime was not set")
+	}
+
+	// Scenario: A successful sync clears the Synced condition.
+	controllers.Synced(cr, false, nil)
+	conditions, _, _ = unstructured.NestedSlice(cr.Object, "status", "conditions")
+	if len(conditions) != 0 {
+		t.Errorf("conditions = %v, want none after a successful sync", conditions)
+	}
+}
@@ -7,6 +7,14 @@ import (
 	"slices"
 )
 
+// syncVolumeSetChildren mirrors the locations and volumes in the volume set's status as child CRs
+func syncVolumeSetChildren(ctx *ExtensionContext, cr *unstructured.Unstructured) error {
+	syncCtx := newSyncContext(ctx, ctx.Client)
+	syncCtx.namespace = cr.GetNamespace()
+	syncCtx.parent = cr
+	return syncVolumeSetStatusLocations(syncCtx, cr)
+}
+
 func syncVolumeSetStatusLocations(ctx *syncContext, volumeSetStatus *unstructured.Unstructured) error {
 	locations := getVolumeSetStatusLocations(volumeSetStatus)
 	deletedLocations, err := syncCRs(ctx, locations, common.VolumesetStatusLocationGVK)
@@ -0,0 +1,272 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	"github.com/controlplane-com/k8s-operator/pkg/websocket"
+	"github.com/controlplane-com/types-go/pkg/deployment"
+	"github.com/controlplane-com/types-go/pkg/workload"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"slices"
+)
+
+func workloadSyncName(ctx cpln.Context, cr *unstructured.Unstructured) string {
+	return fmt.Sprintf("%s.%s.%s", ctx.Org(), ctx.Gvc(), cr.GetName())
+}
+
+// cleanupWorkloadChildren stops the realtime sync of a deleted workload
+func cleanupWorkloadChildren(ctx *ExtensionContext, cr *unstructured.Unstructured) error {
+	return ctx.Syncs.DeregisterSync(workloadSyncName(ctx, cr))
+}
+
+// syncWorkloadChildren starts a realtime sync of the workload's deployments, unless one is already running
+func syncWorkloadChildren(ctx *ExtensionContext, cr *unstructured.Unstructured) error {
+	org := ctx.Org()
+	gvc := ctx.Gvc()
+	token := ctx.Token()
+	fullName := workloadSyncName(ctx, cr)
+	s := ctx.Syncs.GetSync(fullName)
+	if s != nil {
+		return nil
+	}
+	parent := cr.DeepCopy()
+	background := context.Background()
+	l := log.FromContext(background)
+
+	messageHandler := func(message []byte) error {
+		return handleWorkloadStatusMessage(background, ctx, parent, message)
+	}
+	connectHandler := func(w websocket.Client) error {
+		return registerInterest(w, token, Interest{
+			Org:      org,
+			Gvc:      gvc,
+			Workload: cr.GetName(),
+		})
+	}
+
+	w, err := websocket.NewClient(background, l, ctx.Options.WorkloadStatusURL, token, ctx.Options.RealtimeReconnectDelay, messageHandler, connectHandler)
+	if err != nil {
+		return err
+	}
+	ctx.Syncs.RegisterSync(fullName, w)
+	return nil
+}
+
+func syncWorkloadDeployments(ext *ExtensionContext, ctx *syncContext, deployments []deployment.Deployment) error {
+	var deploymentCRs []*unstructured.Unstructured
+	for _, d := range deployments {
+		cr, err := unstructuredCR(common.DeploymentGVK, ctx.namespace, d.Name, d, ctx.parent)
+		if err != nil {
+			return err
+		}
+		setDeploymentHealth(cr, d.Status.Versions)
+		synced(cr, true, nil)
+		delete(cr.Object["status"].(map[string]any), "internal")
+		deploymentCRs = append(deploymentCRs, cr)
+	}
+	summaryMode := ext.Options.WorkloadStatusMode == common.STATUS_MODE_SUMMARY
+	var deletedDeployments []string
+	var err error
+	if summaryMode {
+		//Remove child CRs left over from children mode
+		err = removeStatusCRs(ctx.copy(), common.DeploymentGVK)
+	} else {
+		deletedDeployments, err = syncCRs(ctx.copy(), deploymentCRs, common.DeploymentGVK)
+	}
+	if err != nil {
+		return err
+	}
+
+	currentParent := ctx.parent.DeepCopy()
+	err = ext.Client.Get(ctx, types.NamespacedName{
+		Name:      ctx.parent.GetName(),
+		Namespace: ctx.parent.GetNamespace(),
+	}, currentParent)
+	if err != nil {
+		return err
+	}
+	ctx.parent = currentParent
+
+	if summaryMode {
+		setLocationSummary(ctx.parent, deployments, deploymentCRs)
+	} else {
+		clearLocationSummary(ctx.parent)
+	}
+
+	if err = setWorkloadHealth(ext, ctx, ctx.parent, deployments, deploymentCRs); err != nil {
+		return err
+	}
+	if summaryMode {
+		return nil
+	}
+
+	for i, d := range deployments {
+		if slices.Contains(deletedDeployments, d.Name) {
+			continue
+		}
+		ctx.parent = deploymentCRs[i]
+		if err = syncDeploymentVersions(ctx.copy(), d.Status.Versions); err != nil {
+			return err
+		}
+		if err = syncJobExecutions(ctx.copy(), d.Status.JobExecutions); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func registerInterest(w websocket.Client, token string, interest Interest) error {
+	b, err := json.Marshal(RegisterInterestRequest{
+		Token:     token,
+		Interests: []Interest{interest},
+	})
+	if err != nil {
+		return err
+	}
+	return w.Send(b)
+}
+
+func verifyParent(ext *ExtensionContext, ctx context.Context, parent *unstructured.Unstructured) error {
+	l := log.FromContext(ctx)
+	var currentParent unstructured.Unstructured
+	currentParent.SetGroupVersionKind(parent.GroupVersionKind())
+	//For safety, verify that the parent the websocket client was configured with still matches the one in the cluster
+	if err := ext.Client.Get(ctx, client.ObjectKey{Namespace: parent.GetNamespace(), Name: parent.GetName()}, &currentParent); err != nil {
+		//Do nothing. Can't verify parent identity
+		l.Error(err, "Failed to get parent")
+		return err
+	}
+	if parent.GetUID() != currentParent.GetUID() {
+		*parent = currentParent
+	}
+	return nil
+}
+
+func setDeploymentHealth(deploy *unstructured.Unstructured, versions []deployment.DeploymentVersion) {
+	//Unhealthy?
+	if !anyVersionReady(versions) {
+		unhealthy(deploy)
+		var m []string
+		m = append(m, "All versions of this deployment are unhealthy.")
+		m = append(m, collectDeploymentMessages([]*unstructured.Unstructured{deploy})...)
+		addErrorMessages(deploy, m...)
+		return
+	}
+
+	//Progressing?
+	if anyVersionUnready(versions) {
+		progressing(deploy)
+		return
+	}
+
+	//Ready!
+	ready(deploy)
+}
+
+func setWorkloadHealth(ext *ExtensionContext, ctx context.Context, workload *unstructured.Unstructured, deployments []deployment.Deployment, deploymentCRs []*unstructured.Unstructured) error {
+	setStatus := func() {
+		for _, d := range deploymentCRs {
+			if isUnhealthy(d) {
+				unhealthy(workload)
+				var m []string
+				m = append(m, "At least one deployment of this workload is unhealthy.")
+				m = append(m, collectDeploymentMessages(deploymentCRs)...)
+				addErrorMessages(workload, m...)
+				return
+			}
+		}
+		for _, d := range deploymentCRs {
+			if isProgressing(d) {
+				progressing(workload)
+				return
+			}
+		}
+		for _, d := range deploymentCRs {
+			if !isSuspended(d) {
+				ready(workload)
+				return
+			}
+		}
+		suspended(workload)
+	}
+
+	setStatus()
+	setHealthSummary(workload, deployments, deploymentCRs)
+	return ext.Client.Status().Update(ctx, workload)
+}
+
+func handleWorkloadStatusMessage(background context.Context, ctx *ExtensionContext, parent *unstructured.Unstructured, _ []byte) error {
+	l := log.FromContext(background)
+	syncCtx := newSyncContext(background, ctx.Client)
+	if err := verifyParent(ctx, background, parent); err != nil {
+		return nil
+	}
+	syncCtx.parent = parent
+	syncCtx.namespace = parent.GetNamespace()
+	deployments, err := cpln.GetWorkloadDeploymentsFromCpln(ctx, ctx.Options.HTTPClient, ctx.Connector, parent)
+	if err != nil {
+		l.Error(err, "Failed to get deployments from Cpln")
+		return nil
+	}
+	return syncWorkloadDeployments(ctx, syncCtx, deployments)
+}
+
+// deployTimestampTag is the tag Control Plane watches to force a redeployment of a workload
+const deployTimestampTag = "cpln/deployTimestamp"
+
+// applyWorkloadActions restarts the workload by setting its deploy timestamp to the requested restart time, and
+// suspends it in every location while cpln.io/suspend is "true"
+func applyWorkloadActions(cr *unstructured.Unstructured) {
+	annotations := cr.GetAnnotations()
+	if restartedAt, ok := annotations[common.RESTART_REQUESTED_AT_ANNOTATION]; ok {
+		tags, _ := cr.Object["tags"].(map[string]any)
+		if tags == nil {
+			tags = map[string]any{}
+		}
+		tags[deployTimestampTag] = restartedAt
+		cr.Object["tags"] = tags
+	}
+	if annotations[common.SUSPEND_ANNOTATION] != "true" {
+		return
+	}
+	_ = unstructured.SetNestedField(cr.Object, true, "spec", "defaultOptions", "suspend")
+	localOptions, _, _ := unstructured.NestedSlice(cr.Object, "spec", "localOptions")
+	for _, o := range localOptions {
+		if m, ok := o.(map[string]any); ok {
+			m["suspend"] = true
+		}
+	}
+	if len(localOptions) > 0 {
+		_ = unstructured.SetNestedSlice(cr.Object, localOptions, "spec", "localOptions")
+	}
+}
+
+// runWorkloadActions runs a cron workload in each of its locations when a run is requested
+func runWorkloadActions(ctx *ExtensionContext, cr *unstructured.Unstructured) error {
+	if _, ok := actionRequested(cr, common.RUN_NOW_REQUESTED_AT_ANNOTATION); !ok {
+		return nil
+	}
+	if t, _, _ := unstructured.NestedString(cr.Object, "spec", "type"); t != string(workload.WorkloadTypeCron) {
+		return nil
+	}
+	deployments, err := cpln.GetWorkloadDeploymentsFromCpln(ctx, ctx.Options.HTTPClient, ctx.Connector, cr)
+	if err != nil {
+		return err
+	}
+	for _, d := range deployments {
+		err = cpln.RunCommand(ctx, ctx.Options.HTTPClient, ctx.Connector, cr, "runCronWorkload", map[string]any{
+			"location": d.Name,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package controllers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/controllers"
+	"github.com/controlplane-com/types-go/pkg/containerstatus"
+	"github.com/controlplane-com/types-go/pkg/deployment"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestWorkloadStatusModes(t *testing.T) {
+	ctx := context.Background()
+	gvk := schema.GroupVersionKind{Group: common.API_GROUP, Version: common.API_REVISION, Kind: common.KIND_WORKLOAD}
+	workload := &unstructured.Unstructured{Object: map[string]any{"org": "acme", "gvc": "main"}}
+	workload.SetGroupVersionKind(gvk)
+	workload.SetName("api")
+	workload.SetNamespace("default")
+	workload.SetUID("workload-uid")
+	statusKinds := []schema.GroupVersionKind{common.DeploymentGVK, common.DeploymentVersionGVK, common.ContainerStatusGVK}
+	withStatus := []client.Object{workload}
+	for _, kind := range statusKinds {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(kind)
+		withStatus = append(withStatus, obj)
+	}
+	k8s := fake.NewClientBuilder().WithObjects(workload).WithStatusSubresource(withStatus...).Build()
+	deployments := []deployment.Deployment{{
+		Name: "aws-us-west-2",
+		Status: deployment.DeploymentStatus{
+			Ready: true,
+			Versions: []deployment.DeploymentVersion{{
+				Name:     "v1",
+				Workload: 1,
+				Ready:    true,
+				Containers: deployment.DeploymentVersionContainers{"main": {
+					Name:      "main",
+					Image:     "api:1",
+					Ready:     true,
+					Resources: containerstatus.DeploymentResources{Replicas: 2, ReplicasReady: 2},
+				}},
+			}},
+		},
+	}}
+	sync := func(mode string) *unstructured.Unstructured {
+		t.Helper()
+		if err := controllers.SyncWorkloadDeployments(ctx, k8s, controllers.Options{WorkloadStatusMode: mode}, workload, deployments); err != nil {
+			t.Fatalf("sync in %s mode failed: %v", mode, err)
+		}
+		stored := &unstructured.Unstructured{}
+		stored.SetGroupVersionKind(gvk)
+		if err := k8s.Get(ctx, client.ObjectKeyFromObject(workload), stored); err != nil {
+			t.Fatal(err)
+		}
+		return stored
+	}
+	count := func(gvk schema.GroupVersionKind) int {
+		t.Helper()
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk)
+		if err := k8s.List(ctx, list, client.InNamespace("default")); err != nil {
+			t.Fatal(err)
+		}
+		return len(list.Items)
+	}
+
+	// Scenario: By default, each deployment, version and container gets a child CR.
+	sync(common.STATUS_MODE_CHILDREN)
+	for _, kind := range statusKinds {
+		if n := count(kind); n != 1 {
+			t.Errorf("%s CRs = %d, want 1", kind.Kind, n)
+		}
+	}
+
+	// Scenario: Switching to summary mode removes every child CR left from children mode, down to the container
+	// statuses, and summarizes each location in the workload instead.
+	stored := sync(common.STATUS_MODE_SUMMARY)
+	for _, kind := range statusKinds {
+		if n := count(kind); n != 0 {
+			t.Errorf("%s CRs left in summary mode = %d, want none", kind.Kind, n)
+		}
+	}
+	locations, _, _ := unstructured.NestedSlice(stored.Object, "status", "locations")
+	if len(locations) != 1 {
+		t.Fatalf("locations = %v, want aws-us-west-2", locations)
+	}
+	location := locations[0].(map[string]any)
+	if location["name"] != "aws-us-west-2" || location["phase"] != "Ready" || location["readyReplicas"] != int64(2) || location["totalReplicas"] != int64(2) {
+		t.Errorf("location = %v, want aws-us-west-2 ready with 2 of 2 replicas", location)
+	}
+	versions, _ := location["versions"].([]any)
+	if len(versions) != 1 {
+		t.Fatalf("versions = %v, want v1", versions)
+	}
+	containers, _ := versions[0].(map[string]any)["containers"].([]any)
+	if len(containers) != 1 || containers[0].(map[string]any)["image"] != "api:1" {
+		t.Errorf("containers = %v, want main running api:1", containers)
+	}
+	if readiness, _, _ := unstructured.NestedString(stored.Object, "status", "health", "readiness"); readiness != "ready" {
+		t.Errorf("health readiness = %q, want ready", readiness)
+	}
+
+	// Scenario: Switching back brings the child CRs back and drops the summary.
+	stored = sync(common.STATUS_MODE_CHILDREN)
+	if _, ok, _ := unstructured.NestedSlice(stored.Object, "status", "locations"); ok {
+		t.Error("locations kept in children mode")
+	}
+	for _, kind := range statusKinds {
+		if n := count(kind); n != 1 {
+			t.Errorf("%s CRs after switching back = %d, want 1", kind.Kind, n)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package cpln
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// CredentialProvider supplies the Control Plane token used to manage the resources of an org
+type CredentialProvider interface {
+	Token(ctx context.Context, org string) (string, error)
+}
+
+// CredentialProviderFunc adapts a function to a CredentialProvider
+type CredentialProviderFunc func(ctx context.Context, org string) (string, error)
+
+func (f CredentialProviderFunc) Token(ctx context.Context, org string) (string, error) {
+	return f(ctx, org)
+}
+
+type secretCredentialProvider struct {
+	k8sClient client.Client
+	namespace string
+	secrets   map[string]*corev1.Secret
+	m         sync.Mutex
+}
+
+// NewSecretCredentialProvider reads the token of each org from the token key of the Secret named after the org in the
+// given namespace. Secrets are cached for the lifetime of the provider.
+func NewSecretCredentialProvider(k8sClient client.Client, namespace string) CredentialProvider {
+	return &secretCredentialProvider{
+		k8sClient: k8sClient,
+		namespace: namespace,
+		secrets:   map[string]*corev1.Secret{},
+	}
+}
+
+func (s *secretCredentialProvider) Token(ctx context.Context, org string) (string, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	l := log.FromContext(ctx)
+	secret := s.secrets[org]
+	if secret == nil {
+		secret = &corev1.Secret{}
+		if err := s.k8sClient.Get(ctx, types.NamespacedName{
+			Namespace: s.namespace,
+			Name:      org,
+		}, secret); err != nil {
+			return "", fmt.Errorf("unable to sync resources because the secret %s could not be found. Details: %v", org, err)
+		}
+		s.secrets[org] = secret
+	}
+
+	token := string(secret.Data["token"])
+	if token == "" {
+		// If missing, we can't do anything
+		msg := "secret missing required field: token'"
+		l.Error(nil, msg)
+		return "", errors.New(msg)
+	}
+	return token, nil
+}
@@ -7,17 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"github.com/controlplane-com/k8s-operator/pkg/common"
-	"github.com/controlplane-com/types-go/pkg/base"
-	"github.com/controlplane-com/types-go/pkg/deployment"
 	"io"
-	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/types"
 	"net/http"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
-	"strings"
-	"sync"
+	"slices"
 )
 
 type genericUrlProvider struct {
@@ -28,19 +22,8 @@ func NewGenericUrlProvider(apiUrl string) UrlProvider {
 	return &genericUrlProvider{apiUrl: apiUrl}
 }
 
-var secrets = map[string]*corev1.Secret{}
-var m = &sync.Mutex{}
-
 func (g *genericUrlProvider) ReadUrl(ctx Context, cr *unstructured.Unstructured) string {
-	org := ctx.Org()
-	gvc := ctx.Gvc()
-	kind := cr.GetKind()
-	name := Name(cr)
-	url := fmt.Sprintf("%s/org/%s", g.apiUrl, org)
-	if gvc != "" {
-		url = fmt.Sprintf("%s/gvc/%s", url, gvc)
-	}
-	return fmt.Sprintf("%s/%s/%s", url, strings.ToLower(kind), name)
+	return fmt.Sprintf("%s/%s", ListUrl(g.apiUrl, ctx.Org(), ctx.Gvc(), cr.GetKind()), Name(cr))
 }
 
 func (g *genericUrlProvider) WriteUrl(ctx Context, crdObj *unstructured.Unstructured) string {
@@ -49,15 +32,15 @@ func (g *genericUrlProvider) WriteUrl(ctx Context, crdObj *unstructured.Unstruct
 
 type genericConnector struct {
 	*http.Client
-	k8sClient client.Client
+	credentials CredentialProvider
 	UrlProvider
 	Converter
 }
 
-func NewGenericConnector(client client.Client, apiUrl string) Connector {
+func NewGenericConnector(credentials CredentialProvider, httpClient *http.Client, apiUrl string) Connector {
 	g := &genericConnector{
-		k8sClient: client,
-		Client:    &http.Client{},
+		credentials: credentials,
+		Client:      httpClient,
 	}
 	g.InjectUrlProvider(&genericUrlProvider{
 		apiUrl: apiUrl,
@@ -82,9 +65,9 @@ func (g *genericConnector) Context(ctx context.Context, cr *unstructured.Unstruc
 
 	gvc, _ := cr.Object["gvc"].(string)
 	if common.IsGvcScoped(cr.GetKind()) && gvc == "" {
-		return nil, errors.New(fmt.Sprintf("CRD resource %s/%s is of a gvc-scoped kind (%s), but has no gvc field", cr.GetKind()))
+		return nil, errors.New(fmt.Sprintf("CRD resource %s/%s is of a gvc-scoped kind (%s), but has no gvc field", cr.GetNamespace(), cr.GetName(), cr.GetKind()))
 	}
-	token, err := g.getSecret(ctx, org)
+	token, err := g.credentials.Token(ctx, org)
 	if err != nil {
 		return nil, err
 	}
@@ -128,7 +111,7 @@ func (g *genericConnector) Put(ctx Context, cr *unstructured.Unstructured, dryRu
 	}
 
 	if resp.StatusCode >= 300 {
-		return "", fmt.Errorf("PUT %s -> status %d: %s", url, resp.StatusCode, string(responseJson))
+		return "", common.NewAPIError(http.MethodPut, url, resp, responseJson)
 	}
 	return string(responseJson), nil
 }
@@ -156,7 +139,7 @@ func (g *genericConnector) Get(ctx Context, crdObj *unstructured.Unstructured) (
 	}
 	if resp.StatusCode >= 300 {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("GET %s -> status %d: %s", url, resp.StatusCode, string(body))
+		return nil, common.NewAPIError(http.MethodGet, url, resp, body)
 	}
 	return io.ReadAll(resp.Body)
 }
@@ -191,71 +174,11 @@ func (g *genericConnector) Delete(ctx Context, cr *unstructured.Unstructured) er
 		return nil
 	}
 	if resp.StatusCode >= 300 {
-		return fmt.Errorf("DELETE %s -> status %d: %s", url, resp.StatusCode, string(body))
+		return common.NewAPIError(http.MethodDelete, url, resp, body)
 	}
 	return nil
 }
 
-func (g *genericConnector) getWorkloadDeploymentsFromCpln(ctx Context, crdObj *unstructured.Unstructured) ([]deployment.Deployment, error) {
-	url := g.UrlProvider.ReadUrl(ctx, crdObj)
-	url = fmt.Sprintf("%s/%s", url, "deployment")
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Authorization", "Bearer "+ctx.Token())
-
-	c := g.Client
-	if c == nil {
-		c = http.DefaultClient
-	}
-	resp, err := c.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, common.NotFoundError
-	}
-	if resp.StatusCode >= 300 {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("GET %s -> status %d: %s", url, resp.StatusCode, string(body))
-	}
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	var deployments base.GenericList[deployment.Deployment]
-	return deployments.Items, json.Unmarshal(body, &deployments)
-}
-
-func (g *genericConnector) getSecret(ctx context.Context, org string) (string, error) {
-	m.Lock()
-	defer m.Unlock()
-	l := log.FromContext(ctx)
-	secret, _ := secrets[org]
-	if secret == nil {
-		secret = &corev1.Secret{}
-		if err := g.k8sClient.Get(ctx, types.NamespacedName{
-			Namespace: common.CONTROLLER_NAMESPACE,
-			Name:      fmt.Sprintf("%s", org),
-		}, secret); err != nil {
-			return "", fmt.Errorf("unable to sync resources because the secret %s could not be found. Details: %v", org, err)
-		}
-		secrets[org] = secret
-	}
-
-	token := string(secret.Data["token"])
-	if token == "" {
-		// If missing, we can't do anything
-		msg := "secret missing required field: token'"
-		l.Error(nil, msg)
-		return "", fmt.Errorf(msg)
-	}
-	return token, nil
-}
-
 type genericConverter struct {
 	apiVersion string
 }
@@ -273,6 +196,9 @@ func (g *genericConverter) K8sFormat(ctx Context, template *unstructured.Unstruc
 		Object: map[string]any{},
 	}
 	for key, val := range cpln {
+		if slices.Contains(ServerFields, key) {
+			continue
+		}
 		cr.Object[key] = val
 	}
 	cr.Object["org"] = ctx.Org()
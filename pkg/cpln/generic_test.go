@@ -0,0 +1,45 @@
+package cpln_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestK8sFormatDropsServerFields(t *testing.T) {
+	// Scenario: The fields Control Plane computes are never written into the CR, while everything else is.
+	template := &unstructured.Unstructured{}
+	template.SetKind("workload")
+	template.SetName("api")
+	template.SetNamespace("default")
+	remote := map[string]any{
+		"name":        "api",
+		"description": "the API",
+		"spec":        map[string]any{"type": "standard"},
+		"status":      map[string]any{"canonicalEndpoint": "https://api.example.com"},
+	}
+	for _, field := range cpln.ServerFields {
+		remote[field] = "set by Control Plane"
+	}
+
+	ctx := cpln.NewContext(context.Background(), "acme", "main", "token")
+	cr, err := cpln.NewGenericConverter("cpln.io/v1").K8sFormat(ctx, template, remote)
+	if err != nil {
+		t.Fatalf("K8sFormat returned error: %v", err)
+	}
+	for _, field := range cpln.ServerFields {
+		if _, ok := cr.Object[field]; ok {
+			t.Errorf("the CR has the server field %s", field)
+		}
+	}
+	for _, field := range []string{"description", "spec", "status"} {
+		if _, ok := cr.Object[field]; !ok {
+			t.Errorf("the CR lacks %s", field)
+		}
+	}
+	if cr.Object["org"] != "acme" || cr.Object["gvc"] != "main" {
+		t.Errorf("org/gvc = %v/%v, want acme/main", cr.Object["org"], cr.Object["gvc"])
+	}
+}
@@ -4,13 +4,13 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"slices"
 
 	"github.com/controlplane-com/k8s-operator/pkg/common"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var SPECIAL_SECRET_TYPES = []string{"azure-sdk", "docker", "gcp"}
@@ -19,9 +19,9 @@ type secretUrlProvider struct {
 	UrlProvider
 }
 
-func NewSecretConnector(client client.Client, apiUrl string) Connector {
+func NewSecretConnector(credentials CredentialProvider, httpClient *http.Client, apiUrl string) Connector {
 	s := &secretConnector{
-		Connector: NewGenericConnector(client, apiUrl),
+		Connector: NewGenericConnector(credentials, httpClient, apiUrl),
 	}
 	s.InjectUrlProvider(&secretUrlProvider{
 		UrlProvider: NewGenericUrlProvider(apiUrl),
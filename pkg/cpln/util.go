@@ -1,14 +1,18 @@
 package cpln
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"github.com/controlplane-com/k8s-operator/pkg/common"
 	"github.com/controlplane-com/types-go/pkg/base"
 	"github.com/controlplane-com/types-go/pkg/deployment"
 	"io"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"net/http"
+	neturl "net/url"
+	"slices"
 	"strings"
 )
 
@@ -17,6 +21,23 @@ var k8sBoilerplate = map[string]bool{
 	"metadata":   false,
 }
 
+// IgnoredPointers lists the fields of the CRD that Argo should not diff: the owner references set by the operator, and
+// the ServerFields in its schema
+func IgnoredPointers(crd apiextensionsv1.CustomResourceDefinition) []string {
+	pointers := []string{"/metadata/ownerReferences"}
+	for _, version := range crd.Spec.Versions {
+		if version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+			continue
+		}
+		for _, field := range ServerFields {
+			if _, ok := version.Schema.OpenAPIV3Schema.Properties[field]; ok && !slices.Contains(pointers, "/"+field) {
+				pointers = append(pointers, "/"+field)
+			}
+		}
+	}
+	return pointers
+}
+
 func Name(cr *unstructured.Unstructured) string {
 	m, ok := cr.Object["metadata"].(map[string]any)
 	if !ok {
@@ -90,7 +111,7 @@ func ReadTagsFromAnnotations(cr *unstructured.Unstructured, cpln map[string]any)
 	cpln["tags"] = cplnTags
 }
 
-func GetWorkloadDeploymentsFromCpln(ctx Context, connector Connector, cr *unstructured.Unstructured) ([]deployment.Deployment, error) {
+func GetWorkloadDeploymentsFromCpln(ctx Context, c *http.Client, connector Connector, cr *unstructured.Unstructured) ([]deployment.Deployment, error) {
 	url := connector.ReadUrl(ctx, cr)
 	url = fmt.Sprintf("%s/%s", url, "deployment")
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
@@ -98,7 +119,9 @@ func GetWorkloadDeploymentsFromCpln(ctx Context, connector Connector, cr *unstru
 		return nil, err
 	}
 	req.Header.Set("Authorization", "Bearer "+ctx.Token())
-	c := http.DefaultClient
+	if c == nil {
+		c = http.DefaultClient
+	}
 	resp, err := c.Do(req)
 	if err != nil {
 		return nil, err
@@ -110,7 +133,7 @@ func GetWorkloadDeploymentsFromCpln(ctx Context, connector Connector, cr *unstru
 	}
 	if resp.StatusCode >= 300 {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("GET %s -> status %d: %s", url, resp.StatusCode, string(body))
+		return nil, common.NewAPIError(http.MethodGet, url, resp, body)
 	}
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -119,3 +142,96 @@ func GetWorkloadDeploymentsFromCpln(ctx Context, connector Connector, cr *unstru
 	var deployments base.GenericList[deployment.Deployment]
 	return deployments.Items, json.Unmarshal(body, &deployments)
 }
+
+// RunCommand starts a Control Plane command (e.g. runCronWorkload) against the resource of cr
+func RunCommand(ctx Context, c *http.Client, connector Connector, cr *unstructured.Unstructured, commandType string, spec any) error {
+	url := fmt.Sprintf("%s/%s", connector.ReadUrl(ctx, cr), "-command")
+	payload, err := json.Marshal(map[string]any{
+		"type": commandType,
+		"spec": spec,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+ctx.Token())
+	req.Header.Set("Content-Type", "application/json")
+	if c == nil {
+		c = http.DefaultClient
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return common.NewAPIError(http.MethodPost, url, resp, body)
+	}
+	return nil
+}
+
+// ListUrl is the URL of the Control Plane resources of a kind in the org, or in the gvc for gvc-scoped kinds
+func ListUrl(apiUrl, org, gvc, kind string) string {
+	url := fmt.Sprintf("%s/org/%s", apiUrl, org)
+	if gvc != "" {
+		url = fmt.Sprintf("%s/gvc/%s", url, gvc)
+	}
+	return fmt.Sprintf("%s/%s", url, strings.ToLower(kind))
+}
+
+// List returns every item of a Control Plane list, following its next links
+func List(ctx Context, c *http.Client, url string) ([]map[string]any, error) {
+	if c == nil {
+		c = http.DefaultClient
+	}
+	var items []map[string]any
+	for url != "" {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+ctx.Token())
+		resp, err := c.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode >= 300 {
+			return nil, common.NewAPIError(http.MethodGet, url, resp, body)
+		}
+		var page base.GenericList[map[string]any]
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, err
+		}
+		items = append(items, page.Items...)
+		url, err = nextLink(req.URL, page.Links)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// nextLink resolves the next page of a list against the URL of the current page. It returns "" on the last page.
+func nextLink(current *neturl.URL, links base.Links) (string, error) {
+	for _, link := range links {
+		if link.Rel != "next" || link.Href == "" {
+			continue
+		}
+		next, err := neturl.Parse(link.Href)
+		if err != nil {
+			return "", err
+		}
+		return current.ResolveReference(next).String(), nil
+	}
+	return "", nil
+}
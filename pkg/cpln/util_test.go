@@ -0,0 +1,37 @@
+package cpln_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+func TestIgnoredPointers(t *testing.T) {
+	version := func(fields ...string) apiextensionsv1.CustomResourceDefinitionVersion {
+		props := map[string]apiextensionsv1.JSONSchemaProps{}
+		for _, f := range fields {
+			props[f] = apiextensionsv1.JSONSchemaProps{Type: "string"}
+		}
+		return apiextensionsv1.CustomResourceDefinitionVersion{
+			Name:   "v1",
+			Schema: &apiextensionsv1.CustomResourceValidation{OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{Properties: props}},
+		}
+	}
+	crd := apiextensionsv1.CustomResourceDefinition{}
+
+	// Scenario: Only the server fields in the schema are ignored, along with the owner references.
+	crd.Spec.Versions = []apiextensionsv1.CustomResourceDefinitionVersion{version("id", "version", "description", "spec")}
+	want := []string{"/metadata/ownerReferences", "/id", "/version"}
+	if got := cpln.IgnoredPointers(crd); !slices.Equal(got, want) {
+		t.Errorf("IgnoredPointers = %v, want %v", got, want)
+	}
+
+	// Scenario: A field in several versions is listed once, and a version without a schema is skipped.
+	crd.Spec.Versions = []apiextensionsv1.CustomResourceDefinitionVersion{version("links"), version("links", "created"), {Name: "v2"}}
+	want = []string{"/metadata/ownerReferences", "/links", "/created"}
+	if got := cpln.IgnoredPointers(crd); !slices.Equal(got, want) {
+		t.Errorf("IgnoredPointers = %v, want %v", got, want)
+	}
+}
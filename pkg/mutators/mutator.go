@@ -8,12 +8,19 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"net/http"
+	"reflect"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 	"slices"
 	"strings"
 )
 
 type CrMutator struct {
+	// ManagesNamespace reports whether the operator manages resources in a namespace. Resources it doesn't manage get
+	// no finalizer, since nothing would remove it. All namespaces are managed when nil.
+	ManagesNamespace func(namespace string) bool
+	// ManagesClass reports whether a resource with the given labels belongs to the class of the operator. Resources of
+	// other classes are left to their own operator. All classes are managed when nil.
+	ManagesClass func(labels map[string]string) bool
 }
 
 var ignoredKinds = []string{
@@ -42,9 +49,16 @@ func (c CrMutator) Handle(_ context.Context, req admission.Request) admission.Re
 	if slices.Contains(ignoredKinds, kind) {
 		return admission.Allowed("kind is ignored - ignoring")
 	}
+	if c.ManagesNamespace != nil && !c.ManagesNamespace(req.Namespace) {
+		return admission.Allowed("namespace is not managed - ignoring")
+	}
 	labels := u.GetLabels()
+	if c.ManagesClass != nil && !c.ManagesClass(labels) {
+		return admission.Allowed("resource belongs to another operator class - ignoring")
+	}
 	deletionTimestamp := u.GetDeletionTimestamp()
-	if strings.ToLower(kind) == "secret" && u.GetAPIVersion() == "v1" {
+	isNativeSecret := strings.ToLower(kind) == "secret" && u.GetAPIVersion() == "v1"
+	if isNativeSecret {
 		if len(labels) == 0 {
 			return admission.Allowed("no labels field - ignoring")
 		}
@@ -57,6 +71,18 @@ func (c CrMutator) Handle(_ context.Context, req admission.Request) admission.Re
 		return admission.Allowed("resource has been deleted - ignoring")
 	}
 
+	var old *unstructured.Unstructured
+	if req.Operation == admissionv1.Update && len(req.OldObject.Raw) > 0 {
+		old = &unstructured.Unstructured{Object: make(map[string]any)}
+		if err := json.Unmarshal(req.OldObject.Raw, &old.Object); err != nil {
+			return admission.Errored(http.StatusBadRequest, fmt.Errorf("could not unmarshal raw old object: %v", err))
+		}
+	}
+	recordAuthor(req, old, u)
+	if err := recordApprover(req, old, u); err != nil {
+		return admission.Denied(err.Error())
+	}
+
 	finalizers := u.GetFinalizers()
 	if finalizers == nil {
 		finalizers = []string{}
@@ -86,3 +112,93 @@ func (c CrMutator) Handle(_ context.Context, req admission.Request) admission.Re
 	// Return a PatchResponse which modifies the request object
 	return admission.PatchResponseFromRaw(req.Object.Raw, marshaledObj)
 }
+
+// contentAnnotations change what's pushed to Control Plane, so setting them is authoring a change like editing the spec
+var contentAnnotations = []string{
+	common.SUSPEND_ANNOTATION,
+	common.RESTART_REQUESTED_AT_ANNOTATION,
+	common.RUN_NOW_REQUESTED_AT_ANNOTATION,
+	common.UNMANAGED_FIELDS_ANNOTATION,
+	"cpln.io/name-replacement",
+}
+
+// recordAuthor sets the cpln.io/changed-by annotation to the user who last changed the resource outside of its metadata
+// and status, or changed one of its contentAnnotations. Any other change to cpln.io/changed-by is undone, so that it
+// always names the actual author.
+func recordAuthor(req admission.Request, old, u *unstructured.Unstructured) {
+	annotations := u.GetAnnotations()
+	var changedBy string
+	if old == nil || contentChanged(old, u) {
+		changedBy = req.UserInfo.Username
+	} else {
+		changedBy = old.GetAnnotations()[common.CHANGED_BY_ANNOTATION]
+	}
+	if changedBy == annotations[common.CHANGED_BY_ANNOTATION] {
+		return
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	if changedBy == "" {
+		delete(annotations, common.CHANGED_BY_ANNOTATION)
+	} else {
+		annotations[common.CHANGED_BY_ANNOTATION] = changedBy
+	}
+	u.SetAnnotations(annotations)
+}
+
+// contentChanged reports whether the resource changed outside of its metadata and status, or in its contentAnnotations
+func contentChanged(old, u *unstructured.Unstructured) bool {
+	content := func(obj *unstructured.Unstructured) map[string]any {
+		c := make(map[string]any, len(obj.Object))
+		for k, v := range obj.Object {
+			if k != "metadata" && k != "status" {
+				c[k] = v
+			}
+		}
+		annotations := obj.GetAnnotations()
+		for _, a := range contentAnnotations {
+			if v, ok := annotations[a]; ok {
+				c["metadata.annotations."+a] = v
+			}
+		}
+		return c
+	}
+	return !reflect.DeepEqual(content(old), content(u))
+}
+
+// recordApprover sets the cpln.io/approved-by annotation to the user who set or changed cpln.io/approved-plan. Any
+// other change to cpln.io/approved-by is undone, so that it always names the actual approver. The author of a change,
+// as recorded in cpln.io/changed-by, can't approve it.
+func recordApprover(req admission.Request, old, u *unstructured.Unstructured) error {
+	var previous map[string]string
+	if old != nil {
+		previous = old.GetAnnotations()
+	}
+	annotations := u.GetAnnotations()
+	approved, ok := annotations[common.APPROVED_PLAN_ANNOTATION]
+	approvedBy := previous[common.APPROVED_BY_ANNOTATION]
+	switch {
+	case !ok:
+		approvedBy = ""
+	case approved != previous[common.APPROVED_PLAN_ANNOTATION]:
+		approvedBy = req.UserInfo.Username
+		if author := annotations[common.CHANGED_BY_ANNOTATION]; author != "" && author == approvedBy {
+			return fmt.Errorf("%s changed the resource, so they can't approve the change. Someone else has to set %s",
+				approvedBy, common.APPROVED_PLAN_ANNOTATION)
+		}
+	}
+	if approvedBy == annotations[common.APPROVED_BY_ANNOTATION] {
+		return nil
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	if approvedBy == "" {
+		delete(annotations, common.APPROVED_BY_ANNOTATION)
+	} else {
+		annotations[common.APPROVED_BY_ANNOTATION] = approvedBy
+	}
+	u.SetAnnotations(annotations)
+	return nil
+}
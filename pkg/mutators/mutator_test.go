@@ -0,0 +1,169 @@
+package mutators_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/mutators"
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestRecordApprover(t *testing.T) {
+	workload := func(annotations map[string]string) runtime.RawExtension {
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion(common.API_VERSION)
+		u.SetKind(common.KIND_WORKLOAD)
+		u.SetName("api")
+		u.SetNamespace("prod")
+		u.SetAnnotations(annotations)
+		raw, err := json.Marshal(u.Object)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return runtime.RawExtension{Raw: raw}
+	}
+	approvedBy := func(user string, old, new map[string]string) string {
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Update,
+			Namespace: "prod",
+			UserInfo:  authenticationv1.UserInfo{Username: user},
+			Object:    workload(new),
+			OldObject: workload(old),
+		}}
+		resp := mutators.CrMutator{}.Handle(context.Background(), req)
+		if !resp.Allowed {
+			t.Fatalf("request denied: %v", resp.Result)
+		}
+		patch, err := json.Marshal(resp.Patches)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded, err := jsonpatch.DecodePatch(patch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mutated, err := decoded.Apply(req.Object.Raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		u := &unstructured.Unstructured{}
+		if err := json.Unmarshal(mutated, &u.Object); err != nil {
+			t.Fatal(err)
+		}
+		return u.GetAnnotations()[common.APPROVED_BY_ANNOTATION]
+	}
+
+	// Scenario: The user who sets the approved plan is recorded as the approver.
+	if got := approvedBy("alice", nil, map[string]string{common.APPROVED_PLAN_ANNOTATION: "abc"}); got != "alice" {
+		t.Errorf("approver = %q, want alice", got)
+	}
+
+	// Scenario: Other changes keep the recorded approver, even if they try to change it.
+	old := map[string]string{common.APPROVED_PLAN_ANNOTATION: "abc", common.APPROVED_BY_ANNOTATION: "alice"}
+	forged := map[string]string{common.APPROVED_PLAN_ANNOTATION: "abc", common.APPROVED_BY_ANNOTATION: "bob"}
+	if got := approvedBy("bob", old, forged); got != "alice" {
+		t.Errorf("approver = %q, want alice", got)
+	}
+
+	// Scenario: The approver is dropped with the approval.
+	if got := approvedBy("bob", old, map[string]string{common.APPROVED_BY_ANNOTATION: "alice"}); got != "" {
+		t.Errorf("approver = %q, want none", got)
+	}
+}
+
+func TestRecordAuthor(t *testing.T) {
+	workload := func(image string, annotations map[string]string) runtime.RawExtension {
+		u := &unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{"image": image}}}
+		u.SetAPIVersion(common.API_VERSION)
+		u.SetKind(common.KIND_WORKLOAD)
+		u.SetName("api")
+		u.SetNamespace("prod")
+		u.SetAnnotations(annotations)
+		raw, err := json.Marshal(u.Object)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return runtime.RawExtension{Raw: raw}
+	}
+	handle := func(user string, old, new runtime.RawExtension) admission.Response {
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Update,
+			Namespace: "prod",
+			UserInfo:  authenticationv1.UserInfo{Username: user},
+			Object:    new,
+			OldObject: old,
+		}}
+		return mutators.CrMutator{}.Handle(context.Background(), req)
+	}
+	annotations := func(resp admission.Response, raw runtime.RawExtension) map[string]string {
+		if !resp.Allowed {
+			t.Fatalf("request denied: %v", resp.Result)
+		}
+		patch, err := json.Marshal(resp.Patches)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded, err := jsonpatch.DecodePatch(patch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mutated, err := decoded.Apply(raw.Raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		u := &unstructured.Unstructured{}
+		if err := json.Unmarshal(mutated, &u.Object); err != nil {
+			t.Fatal(err)
+		}
+		return u.GetAnnotations()
+	}
+	authored := map[string]string{common.CHANGED_BY_ANNOTATION: "alice"}
+
+	// Scenario: The user who changes the spec is recorded as its author.
+	new := workload("api:2", nil)
+	if got := annotations(handle("alice", workload("api:1", nil), new), new)[common.CHANGED_BY_ANNOTATION]; got != "alice" {
+		t.Errorf("author = %q, want alice", got)
+	}
+
+	// Scenario: Changes to the metadata alone keep the author, even if they try to change it.
+	new = workload("api:2", map[string]string{common.CHANGED_BY_ANNOTATION: "bob", "team": "web"})
+	if got := annotations(handle("bob", workload("api:2", authored), new), new)[common.CHANGED_BY_ANNOTATION]; got != "alice" {
+		t.Errorf("author = %q, want alice", got)
+	}
+
+	// Scenario: Annotations that change what's pushed make their setter the author, so they can't approve it either.
+	suspended := map[string]string{common.CHANGED_BY_ANNOTATION: "alice", common.SUSPEND_ANNOTATION: "true"}
+	new = workload("api:2", suspended)
+	if got := annotations(handle("bob", workload("api:2", authored), new), new)[common.CHANGED_BY_ANNOTATION]; got != "bob" {
+		t.Errorf("author after suspending = %q, want bob", got)
+	}
+	new = workload("api:2", map[string]string{common.CHANGED_BY_ANNOTATION: "bob", common.SUSPEND_ANNOTATION: "true", common.APPROVED_PLAN_ANNOTATION: "abc"})
+	if resp := handle("bob", workload("api:2", map[string]string{common.CHANGED_BY_ANNOTATION: "bob", common.SUSPEND_ANNOTATION: "true"}), new); resp.Allowed {
+		t.Errorf("approval of one's own suspension allowed")
+	}
+
+	// Scenario: The author can't approve their own change.
+	new = workload("api:2", map[string]string{common.CHANGED_BY_ANNOTATION: "alice", common.APPROVED_PLAN_ANNOTATION: "abc"})
+	if resp := handle("alice", workload("api:2", authored), new); resp.Allowed {
+		t.Errorf("self-approval allowed")
+	}
+
+	// Scenario: Changing the spec and approving it in one go is an approval by the author too.
+	new = workload("api:3", map[string]string{common.CHANGED_BY_ANNOTATION: "alice", common.APPROVED_PLAN_ANNOTATION: "abc"})
+	if resp := handle("bob", workload("api:2", authored), new); resp.Allowed {
+		t.Errorf("approval of one's own change allowed")
+	}
+
+	// Scenario: Someone else can approve it.
+	new = workload("api:2", map[string]string{common.CHANGED_BY_ANNOTATION: "alice", common.APPROVED_PLAN_ANNOTATION: "abc"})
+	if got := annotations(handle("bob", workload("api:2", authored), new), new); got[common.APPROVED_BY_ANNOTATION] != "bob" || got[common.CHANGED_BY_ANNOTATION] != "alice" {
+		t.Errorf("annotations = %v, want approved by bob, changed by alice", got)
+	}
+}
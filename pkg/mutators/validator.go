@@ -0,0 +1,59 @@
+package mutators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// DeletionValidator warns whoever deletes the CR of a protected kind about what happens to its resource in Control
+// Plane. Deletions are never denied, since the controller holds the ones that aren't confirmed.
+type DeletionValidator struct {
+	// DeletionPolicy returns the deletion policy of a kind
+	DeletionPolicy func(kind string) string
+	// ManagesNamespace and ManagesClass are the same as in CrMutator
+	ManagesNamespace func(namespace string) bool
+	ManagesClass     func(labels map[string]string) bool
+}
+
+func (v DeletionValidator) Handle(_ context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Delete || len(req.OldObject.Raw) == 0 {
+		return admission.Allowed("No validation for non-delete")
+	}
+	u := &unstructured.Unstructured{Object: make(map[string]any)}
+	if err := json.Unmarshal(req.OldObject.Raw, &u.Object); err != nil {
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("could not unmarshal raw old object: %v", err))
+	}
+	if v.ManagesNamespace != nil && !v.ManagesNamespace(req.Namespace) {
+		return admission.Allowed("namespace is not managed - ignoring")
+	}
+	if v.ManagesClass != nil && !v.ManagesClass(u.GetLabels()) {
+		return admission.Allowed("resource belongs to another operator class - ignoring")
+	}
+	annotations := u.GetAnnotations()
+	if annotations[common.RESOURCE_POLICY_ANNOTATION] == common.RESOURCE_POLICY_KEEP || v.DeletionPolicy == nil {
+		return admission.Allowed("resource is kept in Control Plane")
+	}
+
+	kind, name := u.GetKind(), u.GetName()
+	switch v.DeletionPolicy(kind) {
+	case common.DELETION_POLICY_NEVER:
+		return admission.Allowed("").WithWarnings(
+			fmt.Sprintf("%s %s is never deleted from Control Plane. Deleting the CR only stops managing it.", kind, name))
+	case common.DELETION_POLICY_CONFIRM:
+		if annotations[common.CONFIRM_DELETE_ANNOTATION] == name {
+			return admission.Allowed("").WithWarnings(
+				fmt.Sprintf("%s %s will be deleted from Control Plane, as confirmed by %s.", kind, name, common.CONFIRM_DELETE_ANNOTATION))
+		}
+		return admission.Allowed("").WithWarnings(fmt.Sprintf(
+			"%s %s won't be deleted from Control Plane until it's annotated with %s: %s, or with %s: %s to remove only the CR. Until then, the CR stays terminating.",
+			kind, name, common.CONFIRM_DELETE_ANNOTATION, name, common.RESOURCE_POLICY_ANNOTATION, common.RESOURCE_POLICY_KEEP))
+	}
+	return admission.Allowed("kind is not protected")
+}
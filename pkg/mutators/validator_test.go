@@ -0,0 +1,72 @@
+package mutators_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/controlplane-com/k8s-operator/pkg/common"
+	"github.com/controlplane-com/k8s-operator/pkg/mutators"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestDeletionValidator(t *testing.T) {
+	policies := map[string]string{
+		common.KIND_ORG: common.DELETION_POLICY_NEVER,
+		common.KIND_GVC: common.DELETION_POLICY_CONFIRM,
+	}
+	validator := mutators.DeletionValidator{DeletionPolicy: func(kind string) string { return policies[kind] }}
+	warnings := func(kind string, annotations map[string]string) []string {
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion(common.API_VERSION)
+		u.SetKind(kind)
+		u.SetName("main")
+		u.SetNamespace("prod")
+		u.SetAnnotations(annotations)
+		raw, err := json.Marshal(u.Object)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp := validator.Handle(context.Background(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Delete,
+			Namespace: "prod",
+			OldObject: runtime.RawExtension{Raw: raw},
+		}})
+		if !resp.Allowed {
+			t.Fatalf("deletion of %s denied: %v", kind, resp.Result)
+		}
+		return resp.Warnings
+	}
+
+	cases := []struct {
+		name        string
+		kind        string
+		annotations map[string]string
+		want        string
+	}{
+		{"org is never deleted", common.KIND_ORG, nil, "is never deleted"},
+		{"unconfirmed gvc", common.KIND_GVC, nil, "won't be deleted"},
+		{"gvc confirmed for another name", common.KIND_GVC, map[string]string{common.CONFIRM_DELETE_ANNOTATION: "other"}, "won't be deleted"},
+		{"confirmed gvc", common.KIND_GVC, map[string]string{common.CONFIRM_DELETE_ANNOTATION: "main"}, "will be deleted"},
+		{"kept gvc", common.KIND_GVC, map[string]string{common.RESOURCE_POLICY_ANNOTATION: common.RESOURCE_POLICY_KEEP}, ""},
+		{"unprotected kind", common.KIND_WORKLOAD, nil, ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := warnings(c.kind, c.annotations)
+			if c.want == "" {
+				if len(got) != 0 {
+					t.Errorf("warnings = %v, want none", got)
+				}
+				return
+			}
+			if len(got) != 1 || !strings.Contains(got[0], c.want) {
+				t.Errorf("warnings = %v, want one containing %q", got, c.want)
+			}
+		})
+	}
+}
@@ -1,31 +1,70 @@
 package realtime
 
-import "time"
+import (
+	"errors"
+	"sync"
+	"time"
+)
 
 type Sync interface {
 	Close() error
 }
 
-var syncs = map[string]Sync{}
+// Registry tracks the realtime syncs started by the controllers, keyed by the full name of the resource they follow
+type Registry struct {
+	m     sync.Mutex
+	syncs map[string]Sync
+}
 
-func RegisterSync(name string, sync Sync) {
-	syncs[name] = sync
+func NewRegistry() *Registry {
+	return &Registry{syncs: map[string]Sync{}}
 }
-func GetSync(name string) Sync {
-	return syncs[name]
+
+func (r *Registry) RegisterSync(name string, sync Sync) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.syncs[name] = sync
 }
-func DeregisterSync(name string) error {
-	s, ok := syncs[name].(Sync)
+
+func (r *Registry) GetSync(name string) Sync {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return r.syncs[name]
+}
+
+func (r *Registry) DeregisterSync(name string) error {
+	r.m.Lock()
+	s, ok := r.syncs[name]
+	delete(r.syncs, name)
+	r.m.Unlock()
 	if !ok {
 		return nil
 	}
-	delete(syncs, name)
 	return s.Close()
 }
 
+// Close stops every registered sync
+func (r *Registry) Close() error {
+	r.m.Lock()
+	syncs := r.syncs
+	r.syncs = map[string]Sync{}
+	r.m.Unlock()
+	var errs []error
+	for _, s := range syncs {
+		errs = append(errs, s.Close())
+	}
+	return errors.Join(errs...)
+}
+
 type Message[T any] struct {
 	Data      T         `json:"data"`
 	EventType string    `json:"eventType"`
 	Id        string    `json:"id"`
 	Timestamp time.Time `json:"timestamp"`
 }
+
+// ResourceChange is the data of a change feed message
+type ResourceChange struct {
+	// Link is the self link of the Control Plane resource that changed, e.g. /org/acme/gvc/main/workload/web
+	Link string `json:"link"`
+}
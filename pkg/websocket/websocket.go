@@ -18,6 +18,10 @@ type MessageHandler func(message []byte) error
 // each time a new connection is established or reestablished.
 type ConnectHandler func(w Client) error
 
+// TokenSource returns the token to authenticate with. It's called before each dial, so that a reconnect never uses a
+// token that expired or was rotated in the meantime.
+type TokenSource func() (string, error)
+
 // Client is the interface for sending and closing the websocket client.
 type Client interface {
 	Send(message []byte) error
@@ -30,7 +34,7 @@ type client struct {
 	ctx            context.Context
 	cancel         context.CancelFunc
 	conn           *websocket.Conn
-	token          string
+	token          TokenSource
 	handler        MessageHandler
 	url            string
 	l              logr.Logger
@@ -56,6 +60,23 @@ func NewClient(
 	onMessage MessageHandler,
 	onConnect ConnectHandler,
 ) (Client, error) {
+	return NewClientWithTokenSource(ctx, l, url, func() (string, error) { return token, nil }, reconnectDelay, onMessage, onConnect)
+}
+
+// NewClientWithTokenSource creates a websocket client that fetches its token from tokens on every dial, and starts the
+// connection loop
+func NewClientWithTokenSource(
+	ctx context.Context,
+	l logr.Logger,
+	url string,
+	tokens TokenSource,
+	reconnectDelay time.Duration,
+	onMessage MessageHandler,
+	onConnect ConnectHandler,
+) (Client, error) {
+	if tokens == nil {
+		return nil, errors.New("tokens is nil")
+	}
 	if onMessage == nil {
 		return nil, errors.New("onMessage is nil")
 	}
@@ -64,7 +85,7 @@ func NewClient(
 		reconnectDelay: reconnectDelay,
 		ctx:            ctx,
 		cancel:         cancel,
-		token:          token,
+		token:          tokens,
 		handler:        onMessage,
 		l:              l,
 		url:            url,
@@ -140,8 +161,12 @@ func (c *client) connect() error {
 	signalConnectionDone := sync.Once{}
 	connectionDone := make(chan error)
 
+	token, err := c.token()
+	if err != nil {
+		return err
+	}
 	header := http.Header{}
-	header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
 	conn, _, err := websocket.DefaultDialer.Dial(c.url, header)
 	if err != nil {
 		return err
@@ -0,0 +1,27 @@
+actions = {}
+
+local annotations = {}
+if obj.metadata and obj.metadata.annotations then
+  annotations = obj.metadata.annotations
+end
+
+actions["resync"] = {}
+if annotations["cpln.io/paused"] == "true" then
+  actions["resume"] = {}
+else
+  actions["pause"] = {}
+end
+
+if obj.kind == "workload" then
+  actions["restart"] = {}
+  if annotations["cpln.io/suspend"] == "true" then
+    actions["unsuspend"] = {}
+  else
+    actions["suspend"] = {}
+  end
+  if obj.spec and obj.spec.type == "cron" then
+    actions["run-now"] = {}
+  end
+end
+
+return actions
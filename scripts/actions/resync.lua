@@ -0,0 +1,5 @@
+if obj.metadata.annotations == nil then
+  obj.metadata.annotations = {}
+end
+obj.metadata.annotations["cpln.io/reconcile-requested-at"] = os.date("!%Y-%m-%dT%H:%M:%SZ")
+return obj
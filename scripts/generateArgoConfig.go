@@ -1,7 +1,10 @@
+//go:build ignore
+
 package main
 
 import (
 	"fmt"
+	"github.com/controlplane-com/k8s-operator/pkg/cpln"
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"log"
@@ -10,6 +13,11 @@ import (
 	"strings"
 )
 
+var (
+	commonActions   = []string{"resync", "pause", "resume"}
+	workloadActions = []string{"restart", "suspend", "unsuspend", "run-now"}
+)
+
 func main() {
 	crdDir := "chart/templates/crd"
 	outputFile := "chart/templates/08-argocd-cm.yaml"
@@ -24,6 +32,9 @@ func main() {
 	var customizationsBuilder strings.Builder
 
 	healthCheckScript, err := os.ReadFile("scripts/healthCheck.lua")
+	if err != nil {
+		log.Fatalf("Failed to read health check script: %v", err)
+	}
 
 	for _, f := range files {
 		if f.IsDir() {
@@ -47,8 +58,39 @@ func main() {
 		customizationsBuilder.WriteString(fmt.Sprintf("  %s:\n", groupKind))
 		customizationsBuilder.WriteString("          health.lua: |\n")
 
-		for _, line := range strings.Split(strings.TrimSuffix(string(healthCheckScript), "\n"), "\n") {
-			customizationsBuilder.WriteString("            " + line + "\n")
+		// A kind can override the generic health check with scripts/health/<kind>.lua
+		script := healthCheckScript
+		if kindScript, err := os.ReadFile(filepath.Join("scripts/health", crd.Spec.Names.Kind+".lua")); err == nil {
+			script = kindScript
+		} else if !os.IsNotExist(err) {
+			log.Fatalf("Failed to read health check script for %s: %v", crd.Spec.Names.Kind, err)
+		}
+
+		writeIndented(&customizationsBuilder, "            ", script)
+		customizationsBuilder.WriteString("\n")
+		customizationsBuilder.WriteString("          ignoreDifferences: |-\n")
+		customizationsBuilder.WriteString("            jsonPointers:\n")
+		for _, pointer := range cpln.IgnoredPointers(crd) {
+			customizationsBuilder.WriteString("              - " + pointer + "\n")
+		}
+		customizationsBuilder.WriteString("\n")
+
+		if !isPushed(crd) {
+			continue
+		}
+		// Resource actions set annotations that the operator acts on in Reconcile
+		definitions := commonActions
+		if crd.Spec.Names.Kind == "workload" {
+			definitions = append(definitions, workloadActions...)
+		}
+		customizationsBuilder.WriteString("          actions: |\n")
+		customizationsBuilder.WriteString("            discovery.lua: |\n")
+		writeIndented(&customizationsBuilder, "              ", readScript("scripts/actions/discovery.lua"))
+		customizationsBuilder.WriteString("            definitions:\n")
+		for _, name := range definitions {
+			customizationsBuilder.WriteString(fmt.Sprintf("              - name: %s\n", name))
+			customizationsBuilder.WriteString("                action.lua: |\n")
+			writeIndented(&customizationsBuilder, "                  ", readScript(filepath.Join("scripts/actions", name+".lua")))
 		}
 	}
 
@@ -56,7 +98,7 @@ func main() {
 kind: ConfigMap
 metadata:
   name: argocd-cm-patch
-  namespace: controlplane
+  namespace: {{ include "cpln-operator.namespace" $ }}
 data:
   argocd-cm-patch.yaml: |-
     data:
@@ -70,3 +112,30 @@ data:
 
 	log.Printf("Generated patch file: %s\n", outputFile)
 }
+
+func readScript(path string) []byte {
+	script, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatalf("Failed to read script: %v", err)
+	}
+	return script
+}
+
+func writeIndented(b *strings.Builder, indent string, script []byte) {
+	for _, line := range strings.Split(strings.TrimSuffix(string(script), "\n"), "\n") {
+		b.WriteString(indent + line + "\n")
+	}
+}
+
+// isPushed reports whether the operator pushes the kind to Control Plane. Kinds that only mirror Control Plane have
+// no Generation Synced column.
+func isPushed(crd v1.CustomResourceDefinition) bool {
+	for _, version := range crd.Spec.Versions {
+		for _, column := range version.AdditionalPrinterColumns {
+			if column.JSONPath == ".status.operator.lastSyncedGenerationTime" {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,547 @@
+//go:build ignore
+
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/controlplane-com/types-go/pkg/agent"
+	"github.com/controlplane-com/types-go/pkg/auditctx"
+	"github.com/controlplane-com/types-go/pkg/base"
+	"github.com/controlplane-com/types-go/pkg/cloudaccount"
+	"github.com/controlplane-com/types-go/pkg/containerstatus"
+	"github.com/controlplane-com/types-go/pkg/cronjob"
+	"github.com/controlplane-com/types-go/pkg/deployment"
+	"github.com/controlplane-com/types-go/pkg/domain"
+	"github.com/controlplane-com/types-go/pkg/group"
+	"github.com/controlplane-com/types-go/pkg/gvc"
+	"github.com/controlplane-com/types-go/pkg/identity"
+	"github.com/controlplane-com/types-go/pkg/image"
+	"github.com/controlplane-com/types-go/pkg/ipSet"
+	"github.com/controlplane-com/types-go/pkg/location"
+	"github.com/controlplane-com/types-go/pkg/mk8s"
+	"github.com/controlplane-com/types-go/pkg/org"
+	"github.com/controlplane-com/types-go/pkg/policy"
+	"github.com/controlplane-com/types-go/pkg/secret"
+	"github.com/controlplane-com/types-go/pkg/serviceaccount"
+	"github.com/controlplane-com/types-go/pkg/user"
+	"github.com/controlplane-com/types-go/pkg/volumeSet"
+	"github.com/controlplane-com/types-go/pkg/workload"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	sigYaml "sigs.k8s.io/yaml"
+)
+
+type crdSource struct {
+	// file is the name of the generated file in chart/templates/crd
+	file string
+	kind string
+	// plural defaults to the English plural of kind
+	plural string
+	// model is the types-go struct the schema is built from
+	model any
+	// gvcScoped kinds require a gvc field in addition to org
+	gvcScoped bool
+	// downstreamOnly kinds are written by the operator to mirror Control Plane status. They are never pushed.
+	downstreamOnly bool
+	// status holds operator-owned status fields that don't come from Control Plane
+	status map[string]apiextensionsv1.JSONSchemaProps
+	// columns are printer columns shown between Phase and Generation Synced
+	columns []apiextensionsv1.CustomResourceColumnDefinition
+	// overrides replaces the schema at a dotted path (with [] for array items). It covers fields the Control Plane
+	// API accepts that the published models don't describe yet. Remove entries once types-go catches up.
+	overrides map[string]apiextensionsv1.JSONSchemaProps
+}
+
+// writableBaseFields are the fields of the base model of every Control Plane resource that can be set through the API.
+// Control Plane computes the others.
+var writableBaseFields = []string{"name", "kind", "description", "tags"}
+
+var (
+	str     = apiextensionsv1.JSONSchemaProps{Type: "string"}
+	num     = apiextensionsv1.JSONSchemaProps{Type: "number"}
+	boolean = apiextensionsv1.JSONSchemaProps{Type: "boolean"}
+)
+
+var sources = []crdSource{
+	{
+		file:  "Agent.yaml",
+		kind:  "agent",
+		model: agent.Agent{},
+		overrides: map[string]apiextensionsv1.JSONSchemaProps{
+			"status.protocolVersion":                 str,
+			"status.bootstrapConfig.protocolVersion": str,
+		},
+	},
+	{file: "AuditContext.yaml", kind: "auditctx", plural: "auditcontexts", model: auditctx.AuditContext{}},
+	{file: "CloudAccount.yaml", kind: "cloudaccount", model: cloudaccount.CloudAccount{}},
+	{file: "ContainerStatus.yaml", kind: "containerstatus", model: containerstatus.ContainerStatus{}, downstreamOnly: true},
+	{
+		file:           "Deployment.yaml",
+		kind:           "deployment",
+		model:          deployment.Deployment{},
+		downstreamOnly: true,
+		overrides: map[string]apiextensionsv1.JSONSchemaProps{
+			"status.jobExecutions[].message": str,
+		},
+	},
+	{file: "DeploymentVersion.yaml", kind: "deploymentversion", model: deployment.DeploymentVersion{}, downstreamOnly: true},
+	{
+		file:  "Domain.yaml",
+		kind:  "domain",
+		model: domain.Domain{},
+		overrides: map[string]apiextensionsv1.JSONSchemaProps{
+			"spec.ports[].routes[].mirror": arrayOf(object(map[string]apiextensionsv1.JSONSchemaProps{
+				"percent":      num,
+				"port":         num,
+				"workloadLink": str,
+			})),
+		},
+	},
+	{file: "Group.yaml", kind: "group", model: group.Group{}},
+	{
+		file:  "Gvc.yaml",
+		kind:  "gvc",
+		model: gvc.Gvc{},
+		overrides: map[string]apiextensionsv1.JSONSchemaProps{
+			"spec.staticPlacement.locationOptions": arrayOf(object(map[string]apiextensionsv1.JSONSchemaProps{
+				"latencyOffsetMs":    num,
+				"latencyToleranceMs": num,
+				"locationLink":       str,
+				"routingTier":        num,
+			})),
+		},
+	},
+	{file: "Identity.yaml", kind: "identity", model: identity.Identity{}, gvcScoped: true},
+	{file: "Image.yaml", kind: "image", model: image.Image{}, downstreamOnly: true},
+	{file: "IpSet.yaml", kind: "ipset", model: ipSet.IpSet{}},
+	{
+		file:           "JobExecutionStatus.yaml",
+		kind:           "jobexecutionstatus",
+		model:          cronjob.JobExecutionStatus{},
+		downstreamOnly: true,
+		overrides: map[string]apiextensionsv1.JSONSchemaProps{
+			"message": str,
+		},
+	},
+	{file: "Location.yaml", kind: "location", model: location.Location{}},
+	{
+		file:  "Mk8sCluster.yaml",
+		kind:  "mk8scluster",
+		model: mk8s.Mk8sCluster{},
+		overrides: map[string]apiextensionsv1.JSONSchemaProps{
+			"spec.addOns.byok.config.juicefs": object(map[string]apiextensionsv1.JSONSchemaProps{
+				"enabled": boolean,
+			}),
+			"spec.provider.aws.nodePools[].cpuOptions": object(map[string]apiextensionsv1.JSONSchemaProps{
+				"nestedVirtualization": boolean,
+			}),
+		},
+	},
+	{file: "Org.yaml", kind: "org", model: org.Org{}},
+	{file: "PersistentVolumeStatus.yaml", kind: "persistentvolumestatus", model: volumeSet.PersistentVolumeStatus{}, downstreamOnly: true},
+	{file: "Policy.yaml", kind: "policy", model: policy.Policy{}},
+	{file: "Secret.yaml", kind: "secret", model: secret.Secret{}},
+	{file: "ServiceAccount.yaml", kind: "serviceaccount", model: serviceaccount.ServiceAccount{}},
+	{file: "User.yaml", kind: "user", model: user.User{}, downstreamOnly: true},
+	{
+		file:      "VolumeSet.yaml",
+		kind:      "volumeset",
+		model:     volumeSet.VolumeSet{},
+		gvcScoped: true,
+		overrides: map[string]apiextensionsv1.JSONSchemaProps{
+			"spec.autoscaling.predictive": object(map[string]apiextensionsv1.JSONSchemaProps{
+				"enabled":                boolean,
+				"lookbackHours":          num,
+				"minDataPoints":          num,
+				"minGrowthRateGBPerHour": num,
+				"projectionHours":        num,
+				"scalingFactor":          num,
+			}),
+		},
+	},
+	{file: "VolumeSetStatusLocation.yaml", kind: "volumesetstatuslocation", model: volumeSet.VolumeSetStatusLocation{}, downstreamOnly: true},
+	{
+		file:      "Workload.yaml",
+		kind:      "workload",
+		model:     workload.Workload{},
+		gvcScoped: true,
+		status:    workloadStatus(),
+		columns: []apiextensionsv1.CustomResourceColumnDefinition{
+			{Name: "Ready Locations", Type: "integer", JSONPath: ".status.health.readyLocations"},
+			{Name: "Locations", Type: "integer", JSONPath: ".status.health.totalLocations"},
+		},
+		overrides: map[string]apiextensionsv1.JSONSchemaProps{
+			// The model declares rolloutOptions as any
+			"spec.rolloutOptions":    schemaFor(reflect.TypeOf(workload.RolloutOptions{}), map[reflect.Type]bool{}),
+			"status.suspendedStatus": str,
+		},
+	},
+}
+
+// main builds the CRDs in chart/templates/crd from the Control Plane types-go models, so that new Control Plane
+// fields are picked up by bumping the types-go dependency and running make generate.
+func main() {
+	crdDir := "chart/templates/crd"
+
+	for _, s := range sources {
+		crd := buildCrd(s)
+
+		// Round trip through a map to drop the zero-valued metadata and status fields of the CRD itself
+		b, err := sigYaml.Marshal(crd)
+		if err != nil {
+			log.Fatalf("Failed to marshal CRD %s: %v", s.kind, err)
+		}
+		var m map[string]any
+		if err = sigYaml.Unmarshal(b, &m); err != nil {
+			log.Fatalf("Failed to unmarshal CRD %s: %v", s.kind, err)
+		}
+		delete(m, "status")
+		delete(m["metadata"].(map[string]any), "creationTimestamp")
+		out, err := sigYaml.Marshal(m)
+		if err != nil {
+			log.Fatalf("Failed to marshal CRD %s: %v", s.kind, err)
+		}
+
+		outputFile := filepath.Join(crdDir, s.file)
+		if err = os.WriteFile(outputFile, out, 0644); err != nil {
+			log.Fatalf("Failed to write CRD file %s: %v", outputFile, err)
+		}
+		fmt.Printf("Wrote CRD file at %s\n", outputFile)
+	}
+
+	writeServerFields("pkg/cpln/serverFields.go")
+}
+
+// writeServerFields generates cpln.ServerFields from the fields of the base model that Control Plane computes
+func writeServerFields(outputFile string) {
+	var fields []string
+	t := reflect.TypeOf(base.Base{})
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" && !slices.Contains(writableBaseFields, name) {
+			fields = append(fields, fmt.Sprintf("%q", name))
+		}
+	}
+	src := fmt.Sprintf(`// Code generated by scripts/generateCrds.go. DO NOT EDIT.
+
+package cpln
+
+// ServerFields are computed by Control Plane on every resource. They are never copied into a CR, since they can't be
+// set from Git and would make Argo report the resource as out of sync.
+var ServerFields = []string{%s}
+`, strings.Join(fields, ", "))
+	out, err := format.Source([]byte(src))
+	if err != nil {
+		log.Fatalf("Failed to format %s: %v", outputFile, err)
+	}
+	if err = os.WriteFile(outputFile, out, 0644); err != nil {
+		log.Fatalf("Failed to write %s: %v", outputFile, err)
+	}
+	fmt.Printf("Wrote server fields at %s\n", outputFile)
+}
+
+func buildCrd(s crdSource) apiextensionsv1.CustomResourceDefinition {
+	plural := s.plural
+	if plural == "" {
+		plural = pluralize(s.kind)
+	}
+
+	// 1. The Control Plane model becomes the root of the schema
+	root := schemaFor(reflect.TypeOf(s.model), map[reflect.Type]bool{})
+	if root.Properties == nil {
+		root.Properties = map[string]apiextensionsv1.JSONSchemaProps{}
+	}
+
+	// 2. Add the fields the operator needs to locate the resource in Control Plane
+	root.Properties["org"] = apiextensionsv1.JSONSchemaProps{
+		Type:        "string",
+		Description: "The organization that owns the resource",
+	}
+	root.Required = []string{"org"}
+	if s.gvcScoped {
+		root.Properties["gvc"] = str
+		root.Required = append(root.Required, "gvc")
+	}
+
+	// 3. Tags are mirrored into annotations, so they are kept as strings
+	if _, ok := root.Properties["tags"]; ok {
+		root.Properties["tags"] = apiextensionsv1.JSONSchemaProps{
+			Type: "object",
+			AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{
+				Allows: true,
+				Schema: &str,
+			},
+		}
+	}
+
+	// 4. Merge the Control Plane status with the status maintained by the operator
+	status, ok := root.Properties["status"]
+	if !ok || status.Type != "object" {
+		status = apiextensionsv1.JSONSchemaProps{Type: "object"}
+	}
+	if status.Properties == nil {
+		status.Properties = map[string]apiextensionsv1.JSONSchemaProps{}
+	}
+	// Control Plane may report status fields that aren't part of the published models yet. Map statuses, like those of
+	// gvcs and audit contexts, are kept that way too, since additionalProperties can't be combined with properties.
+	status.XPreserveUnknownFields = ptr(true)
+	status.AdditionalProperties = nil
+	for k, v := range operatorStatus() {
+		status.Properties[k] = v
+	}
+	for k, v := range s.status {
+		status.Properties[k] = v
+	}
+	root.Properties["status"] = status
+
+	for path, props := range s.overrides {
+		root = override(root, strings.Split(path, "."), props)
+	}
+
+	// 5. Printer columns
+	columns := []apiextensionsv1.CustomResourceColumnDefinition{
+		{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+	}
+	columns = append(columns, s.columns...)
+	if !s.downstreamOnly {
+		columns = append(columns, apiextensionsv1.CustomResourceColumnDefinition{
+			Name: "Generation Synced", Type: "date", JSONPath: ".status.operator.lastSyncedGenerationTime",
+		})
+	}
+	columns = append(columns, apiextensionsv1.CustomResourceColumnDefinition{
+		Name: "Age", Type: "date", JSONPath: ".metadata.creationTimestamp",
+	})
+
+	return apiextensionsv1.CustomResourceDefinition{
+		TypeMeta: v1.TypeMeta{
+			APIVersion: apiextensionsv1.SchemeGroupVersion.String(),
+			Kind:       "CustomResourceDefinition",
+		},
+		ObjectMeta: v1.ObjectMeta{
+			Name: fmt.Sprintf("%s.cpln.io", plural),
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "cpln.io",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Kind:   s.kind,
+				Plural: plural,
+			},
+			Scope: apiextensionsv1.NamespaceScoped,
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{
+					Name:    "v1",
+					Served:  true,
+					Storage: true,
+					Schema: &apiextensionsv1.CustomResourceValidation{
+						OpenAPIV3Schema: &root,
+					},
+					Subresources: &apiextensionsv1.CustomResourceSubresources{
+						Status: &apiextensionsv1.CustomResourceSubresourceStatus{},
+					},
+					AdditionalPrinterColumns: columns,
+				},
+			},
+		},
+	}
+}
+
+// schemaFor converts a types-go model into an OpenAPI schema using the same json tags the operator uses to talk to
+// the Control Plane API
+func schemaFor(t reflect.Type, visiting map[reflect.Type]bool) apiextensionsv1.JSONSchemaProps {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return str
+	case reflect.Bool:
+		return boolean
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return num
+	case reflect.Slice, reflect.Array:
+		items := schemaFor(t.Elem(), visiting)
+		return apiextensionsv1.JSONSchemaProps{
+			Type:  "array",
+			Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &items},
+		}
+	case reflect.Map:
+		values := schemaFor(t.Elem(), visiting)
+		return apiextensionsv1.JSONSchemaProps{
+			Type: "object",
+			AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{
+				Allows: true,
+				Schema: &values,
+			},
+		}
+	case reflect.Struct:
+		// Recursive models can't be expressed in a structural schema, so the recursion stops here
+		if visiting[t] {
+			return apiextensionsv1.JSONSchemaProps{Type: "object", XPreserveUnknownFields: ptr(true)}
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+
+		props := map[string]apiextensionsv1.JSONSchemaProps{}
+		addStructFields(t, props, visiting)
+		return object(props)
+	default:
+		// any, and anything else we can't describe
+		return apiextensionsv1.JSONSchemaProps{XPreserveUnknownFields: ptr(true)}
+	}
+}
+
+func addStructFields(t reflect.Type, props map[string]apiextensionsv1.JSONSchemaProps, visiting map[reflect.Type]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			for ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				addStructFields(ft, props, visiting)
+				continue
+			}
+		}
+		if name == "" {
+			name = f.Name
+		}
+		props[name] = schemaFor(f.Type, visiting)
+	}
+}
+
+func override(s apiextensionsv1.JSONSchemaProps, path []string, props apiextensionsv1.JSONSchemaProps) apiextensionsv1.JSONSchemaProps {
+	if len(path) == 0 {
+		return props
+	}
+	name, isArray := strings.CutSuffix(path[0], "[]")
+	if s.Properties == nil {
+		s.Properties = map[string]apiextensionsv1.JSONSchemaProps{}
+	}
+	child, ok := s.Properties[name]
+	if !ok && len(path) > 1 {
+		log.Fatalf("Override path %s does not exist in the generated schema", strings.Join(path, "."))
+	}
+	if isArray {
+		items := override(*child.Items.Schema, path[1:], props)
+		child.Items = &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &items}
+	} else {
+		child = override(child, path[1:], props)
+	}
+	s.Properties[name] = child
+	return s
+}
+
+func operatorStatus() map[string]apiextensionsv1.JSONSchemaProps {
+	return map[string]apiextensionsv1.JSONSchemaProps{
+		"phase": str,
+		"conditions": arrayOf(object(map[string]apiextensionsv1.JSONSchemaProps{
+			"message": str,
+			"reason":  str,
+			"status":  str,
+			"type":    str,
+		})),
+		"operator": object(map[string]apiextensionsv1.JSONSchemaProps{
+			"appliedActions": {
+				Type:                 "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: true, Schema: &str},
+			},
+			"approvedBy":               str,
+			"downstreamOnly":           boolean,
+			"healthStatusMessage":      str,
+			"lastProcessedGeneration":  num,
+			"lastSyncTime":             {Type: "string", Format: "datetime"},
+			"lastSyncedGeneration":     num,
+			"lastSyncedGenerationTime": {Type: "string", Format: "date-time"},
+			"mergeBase":                str,
+			"nextRetryTime":            {Type: "string", Format: "date-time"},
+			"plan":                     str,
+			"planHash":                 str,
+			"reconcileRequestedAt":     str,
+			"remoteVersion":            num,
+			"syncRetries":              num,
+			"validationError":          str,
+			"wouldHaveDone":            arrayOf(str),
+		}),
+	}
+}
+
+func workloadStatus() map[string]apiextensionsv1.JSONSchemaProps {
+	container := object(map[string]apiextensionsv1.JSONSchemaProps{
+		"image":         str,
+		"message":       str,
+		"name":          str,
+		"ready":         boolean,
+		"readyReplicas": num,
+		"totalReplicas": num,
+	})
+	version := object(map[string]apiextensionsv1.JSONSchemaProps{
+		"containers": arrayOf(container),
+		"message":    str,
+		"name":       str,
+		"ready":      boolean,
+		"workload":   num,
+		"zone":       str,
+	})
+	return map[string]apiextensionsv1.JSONSchemaProps{
+		"health": object(map[string]apiextensionsv1.JSONSchemaProps{
+			"readiness":      str,
+			"readyLocations": num,
+			"readyReplicas":  num,
+			"totalLocations": num,
+			"totalReplicas":  num,
+		}),
+		"locations": arrayOf(object(map[string]apiextensionsv1.JSONSchemaProps{
+			"message":       str,
+			"name":          str,
+			"phase":         str,
+			"ready":         boolean,
+			"readyReplicas": num,
+			"totalReplicas": num,
+			"versions":      arrayOf(version),
+		})),
+	}
+}
+
+func object(props map[string]apiextensionsv1.JSONSchemaProps) apiextensionsv1.JSONSchemaProps {
+	return apiextensionsv1.JSONSchemaProps{Type: "object", Properties: props}
+}
+
+func arrayOf(items apiextensionsv1.JSONSchemaProps) apiextensionsv1.JSONSchemaProps {
+	return apiextensionsv1.JSONSchemaProps{
+		Type:  "array",
+		Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &items},
+	}
+}
+
+func pluralize(kind string) string {
+	switch {
+	case strings.HasSuffix(kind, "y"):
+		return strings.TrimSuffix(kind, "y") + "ies"
+	case strings.HasSuffix(kind, "s"), strings.HasSuffix(kind, "x"):
+		return kind + "es"
+	default:
+		return kind + "s"
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
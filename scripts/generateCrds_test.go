@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+// TestGeneratedCrdsAreValid runs the CRDs written by generateCrds.go through the validation of the API server, which
+// rejects a CRD that helm would otherwise fail to install
+func TestGeneratedCrdsAreValid(t *testing.T) {
+	files, err := filepath.Glob("../chart/templates/crd/*.yaml")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no generated CRDs found: %v", err)
+	}
+	scheme := runtime.NewScheme()
+	if err := apiextensions.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := apiextensionsv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			b, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			crd := &apiextensionsv1.CustomResourceDefinition{}
+			if err := yaml.UnmarshalStrict(b, crd); err != nil {
+				t.Fatalf("malformed CRD: %v", err)
+			}
+			scheme.Default(crd)
+			internal := &apiextensions.CustomResourceDefinition{}
+			if err := scheme.Convert(crd, internal, nil); err != nil {
+				t.Fatalf("converting the CRD failed: %v", err)
+			}
+			for _, err := range validation.ValidateCustomResourceDefinition(context.Background(), internal) {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
+//go:build ignore
+
 package main
 
 import (
+	"bytes"
 	"fmt"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"log"
@@ -13,8 +16,30 @@ import (
 	sigYaml "sigs.k8s.io/yaml" // separate package "sigs.k8s.io/yaml" for marshalling
 )
 
+const namespacePlaceholder = "NAMESPACE"
+
+// The names are prefixed, and the ServiceAccount namespace is picked, by the helpers in chart/templates/_helpers.tpl
+const (
+	prefixPlaceholder            = "PREFIX"
+	operatorNamespacePlaceholder = "OPERATOR_NS"
+)
+
+// rbacTemplate renders the namespaced Roles and RoleBindings when rbacMode is namespaced, and the ClusterRole otherwise
+const rbacTemplate = `{{- if eq .Values.rbacMode "namespaced" }}
+{{- if not .Values.env.WATCH_NAMESPACES }}
+{{- fail "rbacMode namespaced requires env.WATCH_NAMESPACES" }}
+{{- end }}
+{{- range $namespace := splitList "," .Values.env.WATCH_NAMESPACES }}
+%s---
+%s---
+{{- end }}
+{{- else }}
+%s{{- end }}
+`
+
 // GenerateRBAC reads all CRDs in chart/templates/crd, then generates a single
-// ClusterRole that grants get, list, watch on each CRD resource.
+// ClusterRole that grants every verb on each CRD resource, or a Role per watched
+// namespace in the namespaced RBAC mode.
 func main() {
 	crdDir := "chart/templates/crd"
 	outputFile := "chart/templates/00-dynamic-rbac.yaml"
@@ -77,16 +102,56 @@ func main() {
 			Kind:       "ClusterRole",
 		},
 		ObjectMeta: v1.ObjectMeta{
-			Name: "controlplane-operator-crds",
+			Name: prefixPlaceholder + "controlplane-operator-crds",
 		},
 		Rules: rules,
 	}
 
-	// 6. Marshal to YAML
-	out, err := sigYaml.Marshal(clusterRole)
-	if err != nil {
-		log.Fatalf("Failed to marshal ClusterRole to YAML: %v", err)
+	// 6. In the namespaced RBAC mode, the same rules are granted by a Role in each watched namespace instead
+	role := rbacv1.Role{
+		TypeMeta: v1.TypeMeta{
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+			Kind:       "Role",
+		},
+		ObjectMeta: v1.ObjectMeta{
+			Name:      prefixPlaceholder + "controlplane-operator-crds",
+			Namespace: namespacePlaceholder,
+		},
+		Rules: rules,
+	}
+	roleBinding := rbacv1.RoleBinding{
+		TypeMeta: v1.TypeMeta{
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+			Kind:       "RoleBinding",
+		},
+		ObjectMeta: v1.ObjectMeta{
+			Name:      prefixPlaceholder + "controlplane-operator-crds",
+			Namespace: namespacePlaceholder,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     prefixPlaceholder + "controlplane-operator-crds",
+		},
+		Subjects: []rbacv1.Subject{{
+			Kind:      "ServiceAccount",
+			Name:      "operator",
+			Namespace: operatorNamespacePlaceholder,
+		}},
+	}
+
+	// 7. Marshal to YAML, wrapped in the Helm template that picks the RBAC mode
+	var documents [][]byte
+	for _, obj := range []any{clusterRole, role, roleBinding} {
+		b, err := sigYaml.Marshal(obj)
+		if err != nil {
+			log.Fatalf("Failed to marshal %T to YAML: %v", obj, err)
+		}
+		b = bytes.ReplaceAll(b, []byte(operatorNamespacePlaceholder), []byte(`{{ include "cpln-operator.namespace" $ }}`))
+		b = bytes.ReplaceAll(b, []byte(prefixPlaceholder), []byte(`{{ include "cpln-operator.prefix" $ }}`))
+		documents = append(documents, bytes.ReplaceAll(b, []byte(namespacePlaceholder), []byte("{{ trim $namespace }}")))
 	}
+	out := []byte(fmt.Sprintf(rbacTemplate, documents[1], documents[2], documents[0]))
 
 	// Ensure the output directory exists
 	err = os.MkdirAll(filepath.Dir(outputFile), 0755)
@@ -94,7 +159,7 @@ func main() {
 		log.Fatalf("Failed to create directories for %s: %v", outputFile, err)
 	}
 
-	// 7. Write to chart/manifests/rbac/rbac.yaml
+	// 8. Write to chart/templates/00-dynamic-rbac.yaml
 	err = os.WriteFile(outputFile, out, 0644)
 	if err != nil {
 		log.Fatalf("Failed to write RBAC file: %v", err)
@@ -19,6 +19,16 @@ if obj and obj.metadata and obj.metadata.annotations and obj.metadata.annotation
     return hs
 end
 
+if obj.status and obj.status.conditions then
+  for _, condition in ipairs(obj.status.conditions) do
+    if condition.type == "Paused" and condition.status == "True" then
+      hs.status = "Suspended"
+      hs.message = condition.message or "Syncing with Control Plane is paused."
+      return hs
+    end
+  end
+end
+
 if obj.metadata
   and obj.metadata.deletionTimestamp ~= nil
   and obj.status
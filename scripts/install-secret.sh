@@ -1,28 +1,29 @@
 #!/bin/bash
 
 # Check if the required arguments are provided
-if [ "$#" -ne 2 ]; then
-  echo "Usage: $0 <org-name> <org-key>"
+if [ "$#" -lt 2 ]; then
+  echo "Usage: $0 <org-name> <org-key> [namespace]"
   exit 1
 fi
 
 # Assign positional parameters to variables
 ORG_NAME=$1
 ORG_KEY=$2
+NAMESPACE=${3:-controlplane}
 
-# Create the Kubernetes secret in the controlplane namespace
+# Create the Kubernetes secret in the namespace of the operator
 kubectl create secret generic "$ORG_NAME" \
   --from-literal=token="$ORG_KEY" \
-  --namespace=controlplane
+  --namespace="$NAMESPACE"
 
 # Add the label to the secret
 kubectl label secret "$ORG_NAME" \
   app.kubernetes.io/managed-by=cpln-operator \
-  --namespace=controlplane
+  --namespace="$NAMESPACE"
 
 # Check if the secret creation and labeling were successful
 if [ $? -eq 0 ]; then
-  echo "Secret for organization '$ORG_NAME' created and labeled successfully in the 'controlplane' namespace."
+  echo "Secret for organization '$ORG_NAME' created and labeled successfully in the '$NAMESPACE' namespace."
 else
   echo "Failed to create or label secret for organization '$ORG_NAME'."
   exit 1